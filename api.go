@@ -61,6 +61,14 @@ func WithProxyCountry(value string) ScrapeOptions {
 	}
 }
 
+// WithProxyCountryCode specifies the geolocation of the IP for the request using a typed country code.
+// Note: Only applicable for Premium Proxies, which are enabled automatically.
+//
+// value: The desired Country for the proxy, e.g. CountryUS.
+func WithProxyCountryCode(value Country) ScrapeOptions {
+	return WithProxyCountry(string(value))
+}
+
 // WithBlockResources prevents specific resources from loading during the scrape request.
 //
 // value: The types of resources to block.
@@ -71,6 +79,17 @@ func WithBlockResources(value string) ScrapeOptions {
 	}
 }
 
+// WithBlockResourceTypes prevents the given types of resources from loading during the scrape request.
+//
+// types: The ResourceType values to block, e.g. WithBlockResourceTypes(ResourceImage, ResourceFont).
+func WithBlockResourceTypes(types ...ResourceType) ScrapeOptions {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = string(t)
+	}
+	return WithBlockResources(strings.Join(names, ","))
+}
+
 // WithJSONResponse configures the request to return content in JSON format,
 // including any XHR or Fetch requests made.
 //
@@ -112,7 +131,7 @@ func WithCSSExtractor(value string) ScrapeOptions {
 }
 
 // WithAutoparse employs the auto-parser algorithm for the request,
-// which extracts data from the page automatically.
+// which extracts data from the page automatically; Response.Autoparse decodes the result.
 //
 // value: A boolean to determine if the auto parser should be used.
 func WithAutoparse(value bool) ScrapeOptions {
@@ -121,6 +140,16 @@ func WithAutoparse(value bool) ScrapeOptions {
 	}
 }
 
+// WithResponseType makes ZenRows convert the scraped page before returning it, e.g. to Markdown.
+// The page package converts already scraped HTML locally instead.
+//
+// value: The ResponseType to return, e.g. ResponseTypeMarkdown.
+func WithResponseType(value ResponseType) ScrapeOptions {
+	return func(values url.Values) {
+		values.Set("response_type", string(value))
+	}
+}
+
 // WithResolveCaptcha integrates a CAPTCHA solver for the request,
 // enabling automatic solving of CAPTCHAs on the page.
 //
@@ -141,6 +170,13 @@ func WithDevice(value string) ScrapeOptions {
 	}
 }
 
+// WithDeviceType sets the user agent type for the request using a typed Device.
+//
+// value: The Device to use, either DeviceDesktop or DeviceMobile.
+func WithDeviceType(value Device) ScrapeOptions {
+	return WithDevice(string(value))
+}
+
 // WithOriginalStatus configures the request to return the status code as provided by the website.
 //
 // value: A boolean determining if the original status code should be returned.
@@ -189,6 +225,29 @@ func WithAIAntiBot() ScrapeOptions {
 	}
 }
 
+// WithParameter sets an arbitrary ZenRows parameter for the request.
+// It allows using parameters that don't have a dedicated option yet.
+//
+// name: The ZenRows parameter name.
+// value: The value of the parameter.
+func WithParameter(name, value string) ScrapeOptions {
+	return func(values url.Values) {
+		values.Set(name, value)
+	}
+}
+
+// WithoutParameters removes the given parameters from the request.
+// It is mostly useful to unset a client default option for a single call, e.g. WithoutParameters("proxy_country").
+//
+// names: The ZenRows parameter names to remove.
+func WithoutParameters(names ...string) ScrapeOptions {
+	return func(values url.Values) {
+		for _, name := range names {
+			values.Del(name)
+		}
+	}
+}
+
 // ApplyParameters applies the chosen scraping options to a URL.
 // It modifies the URL's query string based on the provided scraping options.
 //
@@ -100,6 +100,31 @@ func TestScrapeOptions(t *testing.T) {
 			zenrows.WithSessionID(12345),
 			url.Values{"session_id": []string{"12345"}},
 		},
+		{
+			"WithProxyCountryCode",
+			zenrows.WithProxyCountryCode(zenrows.CountryBR),
+			url.Values{"premium_proxy": []string{"true"}, "proxy_country": []string{"br"}},
+		},
+		{
+			"WithBlockResourceTypes",
+			zenrows.WithBlockResourceTypes(zenrows.ResourceImage, zenrows.ResourceFont),
+			url.Values{"js_render": []string{"true"}, "block_resources": []string{"image,font"}},
+		},
+		{
+			"WithDeviceType",
+			zenrows.WithDeviceType(zenrows.DeviceMobile),
+			url.Values{"device": []string{"mobile"}},
+		},
+		{
+			"WithResponseType",
+			zenrows.WithResponseType(zenrows.ResponseTypePlaintext),
+			url.Values{"response_type": []string{"plaintext"}},
+		},
+		{
+			"WithParameter",
+			zenrows.WithParameter("outputs", "emails"),
+			url.Values{"outputs": []string{"emails"}},
+		},
 		{
 			"WithAIAntiBot",
 			zenrows.WithAIAntiBot(),
@@ -175,6 +200,14 @@ func TestApplyParameters(t *testing.T) {
 			},
 			url.Values{"js_render": []string{"true"}, "js_instructions": []string{`[{"click":".button"}]`}, "resolve_captcha": []string{"true"}},
 		},
+		{
+			"Multiple Options - WithProxyCountry and WithoutParameters",
+			[]zenrows.ScrapeOptions{
+				zenrows.WithProxyCountry("US"),
+				zenrows.WithoutParameters("proxy_country"),
+			},
+			url.Values{"premium_proxy": []string{"true"}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,247 @@
+package zenrows
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// AutoparseKind is the kind of page detected in an autoparse result.
+type AutoparseKind string
+
+// Kinds of autoparse results.
+const (
+	AutoparseKindProduct AutoparseKind = "product"
+	AutoparseKindArticle AutoparseKind = "article"
+	AutoparseKindSearch  AutoparseKind = "search"
+	// AutoparseKindUnknown is a result whose shape isn't recognized, only its Raw value is set.
+	AutoparseKindUnknown AutoparseKind = "unknown"
+)
+
+// AutoparseResult is the decoded content of a response to a request made WithAutoparse.
+// Only the field matching its Kind is set, along with Raw.
+type AutoparseResult struct {
+	Kind    AutoparseKind
+	Product *AutoparseProduct
+	Article *AutoparseArticle
+	Search  *AutoparseSearch
+	// Raw is the decoded JSON: a map[string]any for objects or a []any for arrays.
+	Raw any
+}
+
+// AutoparseProduct is a product page parsed by ZenRows.
+// Prices and ratings are kept as formatted by the website, e.g. "$29.99" or "4.5 out of 5 stars".
+type AutoparseProduct struct {
+	Title         string   `json:"title,omitempty"`
+	Description   string   `json:"description,omitempty"`
+	Brand         string   `json:"brand,omitempty"`
+	Category      string   `json:"category,omitempty"`
+	SKU           string   `json:"sku,omitempty"`
+	Price         string   `json:"price,omitempty"`
+	OriginalPrice string   `json:"original_price,omitempty"`
+	Currency      string   `json:"currency,omitempty"`
+	Availability  string   `json:"availability,omitempty"`
+	OutOfStock    bool     `json:"out_of_stock,omitempty"`
+	Rating        string   `json:"rating,omitempty"`
+	ReviewCount   string   `json:"review_count,omitempty"`
+	Images        []string `json:"images,omitempty"`
+	URL           string   `json:"url,omitempty"`
+}
+
+// AutoparseArticle is an article page parsed by ZenRows.
+type AutoparseArticle struct {
+	Title         string   `json:"title,omitempty"`
+	Author        string   `json:"author,omitempty"`
+	Description   string   `json:"description,omitempty"`
+	Content       string   `json:"content,omitempty"`
+	PublishedDate string   `json:"published_date,omitempty"`
+	ModifiedDate  string   `json:"modified_date,omitempty"`
+	Image         string   `json:"image,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+	URL           string   `json:"url,omitempty"`
+}
+
+// AutoparseSearch is a search results page parsed by ZenRows.
+type AutoparseSearch struct {
+	Query   string                  `json:"query,omitempty"`
+	Results []AutoparseSearchResult `json:"results"`
+}
+
+// AutoparseSearchResult is a single result of an AutoparseSearch.
+type AutoparseSearchResult struct {
+	Position     int    `json:"position,omitempty"`
+	Title        string `json:"title,omitempty"`
+	URL          string `json:"url,omitempty"`
+	DisplayedURL string `json:"displayed_url,omitempty"`
+	Snippet      string `json:"snippet,omitempty"`
+}
+
+// Keys of the autoparse output, with their aliases across the websites supported by ZenRows.
+var (
+	autoparseTitle       = []string{"title", "name", "headline"}
+	autoparseURL         = []string{"url", "link"}
+	autoparseSearchLists = []string{"organic_results", "search_results", "results"}
+	autoparseProductKeys = []string{"price", "price_without_discount", "out_of_stock", "avg_rating", "sku", "asin"}
+	autoparseArticleKeys = []string{"author", "authors", "published_date", "date_published", "article_body", "content"}
+	autoparseDescription = []string{"description", "summary"}
+)
+
+// Autoparse decodes the body of a response to a request made WithAutoparse, detecting the kind of page.
+func (r *Response) Autoparse() (*AutoparseResult, error) {
+	return ParseAutoparse(r.Body)
+}
+
+// ParseAutoparse decodes the autoparse output of ZenRows, detecting the kind of page.
+// Results whose shape isn't recognized have the AutoparseKindUnknown kind and only their Raw value set.
+func ParseAutoparse(body string) (*AutoparseResult, error) {
+	var raw any
+	if err := json.Unmarshal([]byte(body), &raw); err != nil {
+		return nil, fmt.Errorf("failed to decode autoparse result: %w", err)
+	}
+	result := &AutoparseResult{Kind: AutoparseKindUnknown, Raw: raw}
+
+	switch v := raw.(type) {
+	case []any:
+		if results, ok := searchResults(v); ok {
+			result.Kind = AutoparseKindSearch
+			result.Search = &AutoparseSearch{Results: results}
+		}
+	case map[string]any:
+		for _, key := range autoparseSearchLists {
+			list, _ := v[key].([]any)
+			if results, ok := searchResults(list); ok {
+				result.Kind = AutoparseKindSearch
+				result.Search = &AutoparseSearch{Query: jsonString(v, "query", "search_query"), Results: results}
+				return result, nil
+			}
+		}
+		switch {
+		case hasAnyKey(v, autoparseProductKeys):
+			result.Kind = AutoparseKindProduct
+			result.Product = newAutoparseProduct(v)
+		case hasAnyKey(v, autoparseArticleKeys):
+			result.Kind = AutoparseKindArticle
+			result.Article = newAutoparseArticle(v)
+		}
+	}
+	return result, nil
+}
+
+func newAutoparseProduct(v map[string]any) *AutoparseProduct {
+	return &AutoparseProduct{
+		Title:         jsonString(v, autoparseTitle...),
+		Description:   jsonString(v, autoparseDescription...),
+		Brand:         jsonString(v, "brand"),
+		Category:      jsonString(v, "category"),
+		SKU:           jsonString(v, "sku", "asin"),
+		Price:         jsonString(v, "price"),
+		OriginalPrice: jsonString(v, "price_without_discount", "original_price", "list_price"),
+		Currency:      jsonString(v, "currency", "price_currency"),
+		Availability:  jsonString(v, "availability"),
+		OutOfStock:    jsonBool(v, "out_of_stock"),
+		Rating:        jsonString(v, "avg_rating", "rating"),
+		ReviewCount:   jsonString(v, "review_count", "reviews_count"),
+		Images:        jsonStrings(v, "images", "image"),
+		URL:           jsonString(v, autoparseURL...),
+	}
+}
+
+func newAutoparseArticle(v map[string]any) *AutoparseArticle {
+	return &AutoparseArticle{
+		Title:         jsonString(v, autoparseTitle...),
+		Author:        strings.Join(jsonStrings(v, "author", "authors"), ", "),
+		Description:   jsonString(v, autoparseDescription...),
+		Content:       jsonString(v, "content", "article_body", "body", "text"),
+		PublishedDate: jsonString(v, "published_date", "date_published", "published_at", "date"),
+		ModifiedDate:  jsonString(v, "modified_date", "date_modified", "updated_at"),
+		Image:         jsonString(v, "image", "lead_image", "top_image"),
+		Tags:          jsonStrings(v, "tags", "keywords"),
+		URL:           jsonString(v, autoparseURL...),
+	}
+}
+
+// searchResults decodes a list of search results: objects with a title and a URL.
+func searchResults(list []any) ([]AutoparseSearchResult, bool) {
+	if len(list) == 0 {
+		return nil, false
+	}
+	results := make([]AutoparseSearchResult, 0, len(list))
+	for i, item := range list {
+		v, ok := item.(map[string]any)
+		if !ok || !hasAnyKey(v, autoparseTitle) || !hasAnyKey(v, autoparseURL) {
+			return nil, false
+		}
+		position, err := strconv.Atoi(jsonString(v, "position", "rank"))
+		if err != nil {
+			position = i + 1
+		}
+		results = append(results, AutoparseSearchResult{
+			Position:     position,
+			Title:        jsonString(v, autoparseTitle...),
+			URL:          jsonString(v, autoparseURL...),
+			DisplayedURL: jsonString(v, "displayed_link", "displayed_url"),
+			Snippet:      jsonString(v, "snippet", "description"),
+		})
+	}
+	return results, true
+}
+
+func hasAnyKey(v map[string]any, keys []string) bool {
+	for _, key := range keys {
+		if _, ok := v[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonString returns the value of the first of the keys present, formatted as a string.
+func jsonString(v map[string]any, keys ...string) string {
+	for _, key := range keys {
+		switch value := v[key].(type) {
+		case nil:
+			continue
+		case string:
+			return strings.TrimSpace(value)
+		case float64:
+			return strconv.FormatFloat(value, 'f', -1, 64)
+		case bool:
+			return strconv.FormatBool(value)
+		case map[string]any:
+			// Nested objects such as {"name": "Ann"} authors or brands.
+			if name := jsonString(value, "name", "value"); name != "" {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// jsonStrings returns the values of the first of the keys present, either a list or a single value.
+func jsonStrings(v map[string]any, keys ...string) []string {
+	for _, key := range keys {
+		switch value := v[key].(type) {
+		case nil:
+			continue
+		case []any:
+			values := make([]string, 0, len(value))
+			for _, item := range value {
+				if s := jsonString(map[string]any{"": item}, ""); s != "" {
+					values = append(values, s)
+				}
+			}
+			return values
+		default:
+			if s := jsonString(v, key); s != "" {
+				return []string{s}
+			}
+		}
+	}
+	return nil
+}
+
+func jsonBool(v map[string]any, keys ...string) bool {
+	b, _ := strconv.ParseBool(jsonString(v, keys...))
+	return b
+}
@@ -0,0 +1,110 @@
+package zenrows_test
+
+import (
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAutoparse(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected *zenrows.AutoparseResult
+	}{
+		{
+			"product",
+			`{"title":"Red shoes","brand":{"name":"Acme"},"price":"$50.00","price_without_discount":"$80.00",
+			"avg_rating":"4.5 out of 5 stars","review_count":1234,"out_of_stock":false,"asin":"B0001",
+			"images":["https://shop.test/1.png","https://shop.test/2.png"]}`,
+			&zenrows.AutoparseResult{
+				Kind: zenrows.AutoparseKindProduct,
+				Product: &zenrows.AutoparseProduct{
+					Title:         "Red shoes",
+					Brand:         "Acme",
+					SKU:           "B0001",
+					Price:         "$50.00",
+					OriginalPrice: "$80.00",
+					Rating:        "4.5 out of 5 stars",
+					ReviewCount:   "1234",
+					Images:        []string{"https://shop.test/1.png", "https://shop.test/2.png"},
+				},
+			},
+		},
+		{
+			"article",
+			`{"headline":"Choosing shoes","authors":[{"name":"Ann"},"Bob"],"date_published":"2024-03-01",
+			"article_body":"Shoes matter.","image":"https://blog.test/lead.png","keywords":"shoes"}`,
+			&zenrows.AutoparseResult{
+				Kind: zenrows.AutoparseKindArticle,
+				Article: &zenrows.AutoparseArticle{
+					Title:         "Choosing shoes",
+					Author:        "Ann, Bob",
+					Content:       "Shoes matter.",
+					PublishedDate: "2024-03-01",
+					Image:         "https://blog.test/lead.png",
+					Tags:          []string{"shoes"},
+				},
+			},
+		},
+		{
+			"search object",
+			`{"query":"shoes","organic_results":[
+				{"title":"Red shoes","link":"https://shop.test/red","displayed_link":"shop.test › red","snippet":"Red."},
+				{"position":5,"title":"Blue shoes","url":"https://shop.test/blue"}]}`,
+			&zenrows.AutoparseResult{
+				Kind: zenrows.AutoparseKindSearch,
+				Search: &zenrows.AutoparseSearch{
+					Query: "shoes",
+					Results: []zenrows.AutoparseSearchResult{
+						{Position: 1, Title: "Red shoes", URL: "https://shop.test/red", DisplayedURL: "shop.test › red", Snippet: "Red."},
+						{Position: 5, Title: "Blue shoes", URL: "https://shop.test/blue"},
+					},
+				},
+			},
+		},
+		{
+			"search array",
+			`[{"title":"Red shoes","link":"https://shop.test/red"}]`,
+			&zenrows.AutoparseResult{
+				Kind: zenrows.AutoparseKindSearch,
+				Search: &zenrows.AutoparseSearch{
+					Results: []zenrows.AutoparseSearchResult{{Position: 1, Title: "Red shoes", URL: "https://shop.test/red"}},
+				},
+			},
+		},
+		{
+			"unknown object",
+			`{"results":[{"title":"no link"}],"count":1}`,
+			&zenrows.AutoparseResult{Kind: zenrows.AutoparseKindUnknown},
+		},
+		{
+			"unknown array",
+			`[1,2]`,
+			&zenrows.AutoparseResult{Kind: zenrows.AutoparseKindUnknown},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := (&zenrows.Response{Body: tt.body}).Autoparse()
+			require.NoError(t, err)
+			assert.NotNil(t, result.Raw)
+			result.Raw = nil
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestParseAutoparseRaw(t *testing.T) {
+	result, err := zenrows.ParseAutoparse(`{"count":1,"tags":["a"]}`)
+	require.NoError(t, err)
+	assert.Equal(t, zenrows.AutoparseKindUnknown, result.Kind)
+	assert.Equal(t, map[string]any{"count": float64(1), "tags": []any{"a"}}, result.Raw)
+
+	_, err = zenrows.ParseAutoparse("<html></html>")
+	assert.ErrorContains(t, err, "failed to decode autoparse result")
+}
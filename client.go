@@ -1,7 +1,9 @@
 package zenrows
 
 import (
+	"log/slog"
 	"net/http"
+	"sync/atomic"
 )
 
 // HttpClient Http client able to perform request, can be http.Client or any other
@@ -13,11 +15,20 @@ type HttpClient interface {
 type Client struct {
 	client HttpClient
 	config *ClientConfig
+
+	logger      *slog.Logger
+	logSampling uint64
+	logCounter  atomic.Uint64
+
+	observers   []Observer
+	middlewares []Middleware
 }
 
-// NewClient Initialise the client with given HttpClient interface
-func NewClient(httpClient HttpClient) *Client {
+// NewClient Initialise the client with given HttpClient interface and optional default ScrapeOptions
+// applied to every request made by the client
+func NewClient(httpClient HttpClient, defaults ...ScrapeOptions) *Client {
 	config := DefaultConfig()
+	config.DefaultOptions = defaults
 	return &Client{
 		client: httpClient,
 		config: &config,
@@ -29,3 +40,23 @@ func (c *Client) WithApiKey(key string) *Client {
 	c.config.ConfigCredentials(key)
 	return c
 }
+
+// WithBaseURL Configures the ZenRows API URL, e.g. to use a test server
+func (c *Client) WithBaseURL(baseURL string) *Client {
+	c.config.BaseURL = baseURL
+	return c
+}
+
+// WithApiKeyHeader Sends the apikey in the given request header instead of the query string, keeping it out of URLs.
+// The public ZenRows API authenticates with the apikey query parameter, so only use this when the configured
+// BaseURL accepts the key in a header, e.g. a gateway or proxy in front of ZenRows.
+func (c *Client) WithApiKeyHeader(header string) *Client {
+	c.config.ConfigCredentialsHeader(header)
+	return c
+}
+
+// WithDefaultOptions Adds ScrapeOptions applied to every request before the per-call options
+func (c *Client) WithDefaultOptions(params ...ScrapeOptions) *Client {
+	c.config.DefaultOptions = append(c.config.DefaultOptions, params...)
+	return c
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync/atomic"
+
+	"github.com/renatoaraujo/go-zenrows"
+	"github.com/renatoaraujo/go-zenrows/jobs"
+)
+
+func (c *cli) scrape(ctx context.Context, args []string) error {
+	fs := c.newFlagSet("scrape", "URL")
+	var (
+		clientFlags clientFlags
+		optionFlags optionFlags
+		output      string
+	)
+	clientFlags.register(fs)
+	optionFlags.register(fs)
+	fs.StringVar(&output, "o", "", "write the content to this file instead of stdout")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	client, err := c.newClient(&clientFlags)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Execute(ctx, &zenrows.Call{TargetURL: fs.Arg(0), Options: optionFlags.options()})
+	if err != nil {
+		return err
+	}
+
+	w, closeOutput, err := c.openOutput(output, false)
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, resp.Body); err != nil {
+		_ = closeOutput()
+		return fmt.Errorf("failed to write content: %w", err)
+	}
+	if err := closeOutput(); err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (c *cli) batch(ctx context.Context, args []string) error {
+	fs := c.newFlagSet("batch", "")
+	var (
+		clientFlags clientFlags
+		optionFlags optionFlags
+		input       string
+		output      string
+		state       string
+		retryFailed bool
+		concurrency int
+	)
+	clientFlags.register(fs)
+	optionFlags.register(fs)
+	fs.StringVar(&input, "i", "", "read URLs from this file, one per line, instead of stdin")
+	fs.StringVar(&output, "o", "", "write the NDJSON results to this file instead of stdout")
+	fs.StringVar(&state, "state", "", "track the progress in this file, so an interrupted batch resumes where it stopped")
+	fs.BoolVar(&retryFailed, "retry-failed", false, "scrape the URLs recorded as failed in the -state file again")
+	fs.IntVar(&concurrency, "concurrency", jobs.DefaultConcurrency, "number of concurrent requests")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 || concurrency < 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	client, err := c.newClient(&clientFlags)
+	if err != nil {
+		return err
+	}
+	options, err := zenrows.NewScrapeRequest(optionFlags.options()...)
+	if err != nil {
+		return err
+	}
+
+	r := c.stdin
+	if input != "" {
+		f, err := os.Open(input)
+		if err != nil {
+			return fmt.Errorf("failed to open input: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	runner := &jobs.Runner{Client: client, Concurrency: concurrency, RetryFailed: retryFailed}
+	if state != "" {
+		store, err := jobs.OpenStore(state)
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+		runner.Store = store
+	}
+
+	// A resumed batch appends to the results of the previous runs instead of overwriting them.
+	w, closeOutput, err := c.openOutput(output, state != "")
+	if err != nil {
+		return err
+	}
+
+	var failed atomic.Int64
+	runner.OnResult = func(result jobs.Result) {
+		if result.Failed() {
+			failed.Add(1)
+		}
+	}
+
+	err = runner.Run(ctx, jobs.NewURLReader(r, options), jobs.NewResultWriter(w))
+	if err = errors.Join(err, closeOutput(), ctx.Err()); err != nil {
+		return err
+	}
+	if n := failed.Load(); n > 0 {
+		return fmt.Errorf("%d URLs failed", n)
+	}
+	return nil
+}
+
+func (c *cli) dryRun(_ context.Context, args []string) error {
+	fs := c.newFlagSet("dry-run", "URL")
+	var (
+		clientFlags clientFlags
+		optionFlags optionFlags
+		asJSON      bool
+	)
+	clientFlags.register(fs)
+	optionFlags.register(fs)
+	fs.BoolVar(&asJSON, "json", false, "print the request as JSON instead of a curl command")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	client, err := c.newClient(&clientFlags)
+	if err != nil {
+		return err
+	}
+
+	req, err := client.DryRun(fs.Arg(0), optionFlags.options()...)
+	if err != nil {
+		return err
+	}
+
+	if asJSON {
+		return c.printJSON(req)
+	}
+	_, err = fmt.Fprintln(c.stdout, req.Curl())
+	return err
+}
+
+func (c *cli) usage(ctx context.Context, args []string) error {
+	fs := c.newFlagSet("usage", "")
+	var clientFlags clientFlags
+	clientFlags.register(fs)
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	client, err := c.newClient(&clientFlags)
+	if err != nil {
+		return err
+	}
+
+	usage, err := client.Usage(ctx)
+	if err != nil {
+		return err
+	}
+	return c.printJSON(usage)
+}
+
+func (c *cli) printJSON(v any) error {
+	encoder := json.NewEncoder(c.stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// openOutput returns the file at path, or stdout when path is empty, and a function closing it.
+// The file is truncated unless appending is requested.
+func (c *cli) openOutput(path string, appending bool) (io.Writer, func() error, error) {
+	if path == "" {
+		return c.stdout, func() error { return nil }, nil
+	}
+	flag := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if appending {
+		flag = os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	}
+	f, err := os.OpenFile(path, flag, 0o644)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create output: %w", err)
+	}
+	return f, f.Close, nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/renatoaraujo/go-zenrows"
+)
+
+// config is the content of the config file.
+type config struct {
+	APIKey  string `json:"api_key"`
+	BaseURL string `json:"base_url,omitempty"`
+}
+
+// clientFlags are the flags shared by every command creating a client.
+type clientFlags struct {
+	configPath string
+	timeout    time.Duration
+}
+
+func (f *clientFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.configPath, "config", "", "path of the JSON config file (default $XDG_CONFIG_HOME/zenrows/config.json)")
+	fs.DurationVar(&f.timeout, "timeout", 3*time.Minute, "timeout of each request")
+}
+
+// newClient creates a zenrows.Client, with the apikey from the ZENROWS_API_KEY environment variable or the config file.
+func (c *cli) newClient(f *clientFlags) (*zenrows.Client, error) {
+	cfg, err := c.loadConfig(f.configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if key := c.getenv("ZENROWS_API_KEY"); key != "" {
+		cfg.APIKey = key
+	}
+	if cfg.APIKey == "" {
+		return nil, errors.New("missing apikey, set ZENROWS_API_KEY or api_key in the config file")
+	}
+
+	client := zenrows.NewClient(&http.Client{Timeout: f.timeout}).WithApiKey(cfg.APIKey)
+	if cfg.BaseURL != "" {
+		client.WithBaseURL(cfg.BaseURL)
+	}
+	return client, nil
+}
+
+func (c *cli) loadConfig(path string) (config, error) {
+	explicit := path != ""
+	if !explicit {
+		dir := c.getenv("XDG_CONFIG_HOME")
+		if dir == "" {
+			home := c.getenv("HOME")
+			if home == "" {
+				return config{}, nil
+			}
+			dir = filepath.Join(home, ".config")
+		}
+		path = filepath.Join(dir, "zenrows", "config.json")
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && !explicit {
+		return config{}, nil
+	}
+	if err != nil {
+		return config{}, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var cfg config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return config{}, fmt.Errorf("failed to decode config file %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// newFlagSet creates a FlagSet for the given command reporting errors to the cli stderr.
+func (c *cli) newFlagSet(name, args string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(c.stderr, "Usage: zenrows %s [flags] %s\n\nFlags:\n", name, args)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parseFlags parses args, turning flag errors into errUsage.
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+// Command zenrows scrapes websites with the ZenRows API from the command line.
+//
+// Usage:
+//
+//	zenrows scrape [flags] URL     scrape a single URL
+//	zenrows batch [flags]          scrape URLs read from a file or stdin
+//	zenrows dry-run [flags] URL    print the request that would be sent, without sending it
+//	zenrows usage [flags]          print the account usage
+//
+// The apikey is read from the ZENROWS_API_KEY environment variable or from the api_key field of the
+// JSON config file, by default $XDG_CONFIG_HOME/zenrows/config.json.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	c := &cli{stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr, getenv: os.Getenv}
+	code := c.run(ctx, os.Args[1:])
+	stop()
+	os.Exit(code)
+}
+
+const usageText = `Usage: zenrows <command> [flags]
+
+Commands:
+  scrape    scrape a single URL
+  batch     scrape URLs read from a file or stdin
+  dry-run   print the request that would be sent, without sending it
+  usage     print the account usage
+
+Run 'zenrows <command> -h' for the flags of a command.
+`
+
+// errUsage is returned when the command line is invalid, the reason has already been reported.
+var errUsage = errors.New("invalid usage")
+
+// cli holds the environment of a command run.
+type cli struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+	getenv func(key string) string
+}
+
+func (c *cli) run(ctx context.Context, args []string) int {
+	if len(args) == 0 {
+		fmt.Fprint(c.stderr, usageText)
+		return 2
+	}
+
+	commands := map[string]func(context.Context, []string) error{
+		"scrape":  c.scrape,
+		"batch":   c.batch,
+		"dry-run": c.dryRun,
+		"usage":   c.usage,
+	}
+
+	command, ok := commands[args[0]]
+	if !ok {
+		if args[0] != "-h" && args[0] != "-help" && args[0] != "help" {
+			fmt.Fprintf(c.stderr, "unknown command %q\n\n", args[0])
+		}
+		fmt.Fprint(c.stderr, usageText)
+		return 2
+	}
+
+	if err := command(ctx, args[1:]); err != nil {
+		if errors.Is(err, errUsage) {
+			return 2
+		}
+		fmt.Fprintf(c.stderr, "zenrows %s: %v\n", args[0], err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	"github.com/renatoaraujo/go-zenrows/jobs"
+	"github.com/renatoaraujo/go-zenrows/zenrowstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCLI(t *testing.T, srv *zenrowstest.Server, stdin string) (*cli, *bytes.Buffer, *bytes.Buffer) {
+	t.Helper()
+
+	dir := t.TempDir()
+	cfg, err := json.Marshal(config{BaseURL: srv.URL})
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "zenrows"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "zenrows", "config.json"), cfg, 0o600))
+
+	env := map[string]string{"ZENROWS_API_KEY": "test-key", "XDG_CONFIG_HOME": dir}
+	var stdout, stderr bytes.Buffer
+	return &cli{
+		stdin:  strings.NewReader(stdin),
+		stdout: &stdout,
+		stderr: &stderr,
+		getenv: func(key string) string { return env[key] },
+	}, &stdout, &stderr
+}
+
+func TestScrapeCommand(t *testing.T) {
+	srv := zenrowstest.NewServer("test-key")
+	defer srv.Close()
+	srv.Handle("https://example.com", zenrowstest.Fixture{Body: "<html>example</html>"})
+
+	c, stdout, stderr := newTestCLI(t, srv, "")
+	code := c.run(context.Background(), []string{"scrape", "-js-render", "-device", "mobile", "-param", "outputs=emails", "https://example.com"})
+	require.Equal(t, 0, code, stderr.String())
+	assert.Equal(t, "<html>example</html>", stdout.String())
+
+	requests := srv.Requests()
+	require.Len(t, requests, 1)
+	assert.Equal(t, zenrows.Bool(true), requests[0].Options.JSRender)
+	assert.Equal(t, "mobile", requests[0].Options.Device)
+	assert.Equal(t, map[string]string{"outputs": "emails"}, requests[0].Options.Extra)
+}
+
+func TestScrapeCommandOutputFile(t *testing.T) {
+	srv := zenrowstest.NewServer("test-key")
+	defer srv.Close()
+	srv.Handle("https://example.com", zenrowstest.Fixture{Body: "<html>example</html>"})
+
+	output := filepath.Join(t.TempDir(), "out.html")
+	c, stdout, stderr := newTestCLI(t, srv, "")
+	code := c.run(context.Background(), []string{"scrape", "-o", output, "https://example.com"})
+	require.Equal(t, 0, code, stderr.String())
+	assert.Empty(t, stdout.String())
+
+	content, err := os.ReadFile(output)
+	require.NoError(t, err)
+	assert.Equal(t, "<html>example</html>", string(content))
+}
+
+func TestScrapeCommandErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		expectedCode int
+	}{
+		{"Missing URL", []string{"scrape"}, 2},
+		{"Unknown flag", []string{"scrape", "-nope", "https://example.com"}, 2},
+		{"Invalid options", []string{"scrape", "-wait", "-1", "https://example.com"}, 1},
+		{"Unexpected status", []string{"scrape", "https://example.com/missing"}, 1},
+		{"Unknown command", []string{"crawl"}, 2},
+		{"No command", nil, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := zenrowstest.NewServer("test-key")
+			defer srv.Close()
+
+			c, _, stderr := newTestCLI(t, srv, "")
+			assert.Equal(t, tt.expectedCode, c.run(context.Background(), tt.args))
+			assert.NotEmpty(t, stderr.String())
+		})
+	}
+}
+
+func TestBatchCommand(t *testing.T) {
+	srv := zenrowstest.NewServer("test-key")
+	defer srv.Close()
+	srv.Handle("https://example.com/a", zenrowstest.Fixture{Body: "page a"})
+	srv.Handle("https://example.com/b", zenrowstest.Fixture{Body: "page b"})
+
+	c, stdout, stderr := newTestCLI(t, srv, "https://example.com/a\n\n# comment\nhttps://example.com/b\n")
+	code := c.run(context.Background(), []string{"batch", "-concurrency", "2"})
+	require.Equal(t, 0, code, stderr.String())
+
+	bodies := map[string]string{}
+	for _, line := range strings.Split(strings.TrimSpace(stdout.String()), "\n") {
+		var result jobs.Result
+		require.NoError(t, json.Unmarshal([]byte(line), &result))
+		assert.Equal(t, 200, result.Status)
+		assert.Equal(t, float64(1), result.Cost)
+		bodies[result.TargetURL] = result.Body
+	}
+	assert.Equal(t, map[string]string{"https://example.com/a": "page a", "https://example.com/b": "page b"}, bodies)
+}
+
+func TestBatchCommandReportsFailures(t *testing.T) {
+	srv := zenrowstest.NewServer("test-key")
+	defer srv.Close()
+	srv.Handle("https://example.com/a", zenrowstest.Fixture{Body: "page a"})
+
+	c, stdout, stderr := newTestCLI(t, srv, "https://example.com/a\nnot-a-url\n")
+	code := c.run(context.Background(), []string{"batch"})
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr.String(), "1 URLs failed")
+	assert.Len(t, strings.Split(strings.TrimSpace(stdout.String()), "\n"), 2)
+}
+
+func TestBatchCommandResume(t *testing.T) {
+	srv := zenrowstest.NewServer("test-key")
+	defer srv.Close()
+	srv.HandleDefault(zenrowstest.Fixture{Body: "ok"})
+	srv.Handle("https://example.com/missing", zenrowstest.Fixture{StatusCode: 404})
+
+	dir := t.TempDir()
+	input := filepath.Join(dir, "urls.txt")
+	output := filepath.Join(dir, "results.ndjson")
+	state := filepath.Join(dir, "state.log")
+	require.NoError(t, os.WriteFile(input, []byte("https://example.com/a\nhttps://example.com/missing\n"), 0o644))
+	args := []string{"batch", "-js-render", "-i", input, "-o", output, "-state", state}
+
+	c, _, _ := newTestCLI(t, srv, "")
+	assert.Equal(t, 1, c.run(context.Background(), args))
+	assert.Len(t, srv.Requests(), 2)
+
+	// The batch is extended with a new URL: only that one is scraped and the results are appended.
+	require.NoError(t, os.WriteFile(input, []byte("https://example.com/a\nhttps://example.com/missing\nhttps://example.com/b\n"), 0o644))
+	c, _, stderr := newTestCLI(t, srv, "")
+	require.Equal(t, 0, c.run(context.Background(), args), stderr.String())
+	requests := srv.Requests()
+	require.Len(t, requests, 3)
+	assert.Equal(t, "https://example.com/b", requests[2].TargetURL)
+
+	content, err := os.ReadFile(output)
+	require.NoError(t, err)
+	assert.Equal(t, 3, strings.Count(string(content), "\n"))
+
+	c, _, _ = newTestCLI(t, srv, "")
+	assert.Equal(t, 1, c.run(context.Background(), append(args, "-retry-failed")))
+	assert.Len(t, srv.Requests(), 4)
+}
+
+func TestDryRunCommand(t *testing.T) {
+	srv := zenrowstest.NewServer("test-key")
+	defer srv.Close()
+
+	c, stdout, stderr := newTestCLI(t, srv, "")
+	code := c.run(context.Background(), []string{"dry-run", "-premium-proxy", "https://example.com"})
+	require.Equal(t, 0, code, stderr.String())
+	assert.Contains(t, stdout.String(), "curl -X GET")
+	assert.Contains(t, stdout.String(), "premium_proxy=true")
+	assert.NotContains(t, stdout.String(), "test-key")
+	assert.Empty(t, srv.Requests())
+}
+
+func TestUsageCommand(t *testing.T) {
+	srv := zenrowstest.NewServer("test-key")
+	defer srv.Close()
+
+	c, stdout, stderr := newTestCLI(t, srv, "")
+	code := c.run(context.Background(), []string{"usage"})
+	require.Equal(t, 0, code, stderr.String())
+	assert.Contains(t, stdout.String(), `"api_credit_limit": 1000`)
+}
+
+func TestMissingAPIKey(t *testing.T) {
+	var stderr bytes.Buffer
+	c := &cli{
+		stdin:  strings.NewReader(""),
+		stdout: &bytes.Buffer{},
+		stderr: &stderr,
+		getenv: func(key string) string {
+			if key == "XDG_CONFIG_HOME" {
+				return t.TempDir()
+			}
+			return ""
+		},
+	}
+
+	assert.Equal(t, 1, c.run(context.Background(), []string{"usage"}))
+	assert.Contains(t, stderr.String(), "missing apikey")
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/renatoaraujo/go-zenrows"
+)
+
+// optionFlags mirrors every zenrows With* option as a command line flag.
+// Only the flags explicitly set on the command line are turned into options.
+type optionFlags struct {
+	fs *flag.FlagSet
+
+	jsRender       bool
+	jsInstructions string
+	customHeaders  bool
+	premiumProxy   bool
+	proxyCountry   string
+	blockResources string
+	jsonResponse   bool
+	windowWidth    int
+	windowHeight   int
+	cssExtractor   string
+	autoparse      bool
+	resolveCaptcha bool
+	device         string
+	originalStatus bool
+	waitFor        string
+	wait           int
+	sessionID      int
+	antibot        bool
+	responseType   string
+	params         paramsFlag
+}
+
+func (f *optionFlags) register(fs *flag.FlagSet) {
+	f.fs = fs
+	fs.BoolVar(&f.jsRender, "js-render", false, "enable JavaScript rendering")
+	fs.StringVar(&f.jsInstructions, "js-instructions", "", "JSON array of JavaScript instructions")
+	fs.BoolVar(&f.customHeaders, "custom-headers", false, "forward custom headers to the target")
+	fs.BoolVar(&f.premiumProxy, "premium-proxy", false, "use premium proxies")
+	fs.StringVar(&f.proxyCountry, "proxy-country", "", "ISO 3166-1 country code of the premium proxy")
+	fs.StringVar(&f.blockResources, "block-resources", "", "comma separated resource types to block")
+	fs.BoolVar(&f.jsonResponse, "json-response", false, "return the content and XHR requests as JSON")
+	fs.IntVar(&f.windowWidth, "window-width", 0, "browser window width in pixels")
+	fs.IntVar(&f.windowHeight, "window-height", 0, "browser window height in pixels")
+	fs.StringVar(&f.cssExtractor, "css-extractor", "", "CSS selectors to extract")
+	fs.BoolVar(&f.autoparse, "autoparse", false, "use the auto parser")
+	fs.BoolVar(&f.resolveCaptcha, "resolve-captcha", false, "solve CAPTCHAs on the page")
+	fs.StringVar(&f.device, "device", "", "device type, desktop or mobile")
+	fs.BoolVar(&f.originalStatus, "original-status", false, "return the status code of the target")
+	fs.StringVar(&f.waitFor, "wait-for", "", "CSS selector to wait for")
+	fs.IntVar(&f.wait, "wait", 0, "fixed wait in milliseconds")
+	fs.IntVar(&f.sessionID, "session-id", 0, "session ID to keep the same IP")
+	fs.BoolVar(&f.antibot, "antibot", false, "enable the anti-bot bypass")
+	fs.StringVar(&f.responseType, "response-type", "", "convert the page to markdown, plaintext or pdf")
+	fs.Var(&f.params, "param", "additional ZenRows parameter as name=value, can be repeated")
+}
+
+// options returns the ScrapeOptions of the flags set on the command line.
+// Parameters given with -param are applied last, so they take precedence.
+func (f *optionFlags) options() []zenrows.ScrapeOptions {
+	var options []zenrows.ScrapeOptions
+	f.fs.Visit(func(fl *flag.Flag) {
+		switch fl.Name {
+		case "js-render":
+			if f.jsRender {
+				options = append(options, zenrows.WithJSRender())
+			}
+		case "js-instructions":
+			options = append(options, zenrows.WithJSInstructions(f.jsInstructions))
+		case "custom-headers":
+			options = append(options, zenrows.WithCustomHeaders(f.customHeaders))
+		case "premium-proxy":
+			if f.premiumProxy {
+				options = append(options, zenrows.WithPremiumProxy())
+			}
+		case "proxy-country":
+			options = append(options, zenrows.WithProxyCountry(f.proxyCountry))
+		case "block-resources":
+			options = append(options, zenrows.WithBlockResources(f.blockResources))
+		case "json-response":
+			options = append(options, zenrows.WithJSONResponse(f.jsonResponse))
+		case "window-width":
+			options = append(options, zenrows.WithWindowWidth(f.windowWidth))
+		case "window-height":
+			options = append(options, zenrows.WithWindowHeight(f.windowHeight))
+		case "css-extractor":
+			options = append(options, zenrows.WithCSSExtractor(f.cssExtractor))
+		case "autoparse":
+			options = append(options, zenrows.WithAutoparse(f.autoparse))
+		case "resolve-captcha":
+			options = append(options, zenrows.WithResolveCaptcha(f.resolveCaptcha))
+		case "device":
+			options = append(options, zenrows.WithDevice(f.device))
+		case "original-status":
+			options = append(options, zenrows.WithOriginalStatus(f.originalStatus))
+		case "wait-for":
+			options = append(options, zenrows.WithWaitFor(f.waitFor))
+		case "wait":
+			options = append(options, zenrows.WithWait(f.wait))
+		case "session-id":
+			options = append(options, zenrows.WithSessionID(f.sessionID))
+		case "antibot":
+			if f.antibot {
+				options = append(options, zenrows.WithAIAntiBot())
+			}
+		case "response-type":
+			options = append(options, zenrows.WithResponseType(zenrows.ResponseType(f.responseType)))
+		}
+	})
+	for _, p := range f.params {
+		options = append(options, zenrows.WithParameter(p[0], p[1]))
+	}
+	return options
+}
+
+// paramsFlag collects repeated name=value flags.
+type paramsFlag [][2]string
+
+func (p *paramsFlag) String() string {
+	pairs := make([]string, len(*p))
+	for i, pair := range *p {
+		pairs[i] = pair[0] + "=" + pair[1]
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (p *paramsFlag) Set(value string) error {
+	name, v, ok := strings.Cut(value, "=")
+	if !ok || name == "" {
+		return fmt.Errorf("expected name=value, got %q", value)
+	}
+	*p = append(*p, [2]string{name, v})
+	return nil
+}
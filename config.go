@@ -2,11 +2,16 @@ package zenrows
 
 const zenRowsAPIURLv1 = "https://api.zenrows.com/v1/"
 
-// ClientConfig Configuration with the key and base API URL
+// ClientConfig Configuration with the key, base API URL and default scrape options
 type ClientConfig struct {
-	key string
+	key       string
+	keyHeader string
 
 	BaseURL string
+
+	// DefaultOptions are applied to every scrape request before the per-call options,
+	// so per-call options always take precedence. Use WithoutParameters to drop a default for a single call.
+	DefaultOptions []ScrapeOptions
 }
 
 // DefaultConfig Generate default configuration -- currently only option but extensive for the future
@@ -20,3 +25,8 @@ func DefaultConfig() ClientConfig {
 func (c *ClientConfig) ConfigCredentials(key string) {
 	c.key = key
 }
+
+// ConfigCredentialsHeader Sends the apikey in the given request header instead of the apikey query parameter
+func (c *ClientConfig) ConfigCredentialsHeader(header string) {
+	c.keyHeader = header
+}
@@ -0,0 +1,124 @@
+package zenrows
+
+import (
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+)
+
+// Headers in which ZenRows returns the cookies set by the target website.
+var cookieHeaders = []string{"Zr-Cookies", "Zr-Set-Cookie"}
+
+// cookieAttributes are the Set-Cookie attributes, anything else in a cookie header is a new cookie.
+var cookieAttributes = map[string]struct{}{
+	"path": {}, "domain": {}, "expires": {}, "max-age": {}, "secure": {}, "httponly": {}, "samesite": {}, "partitioned": {},
+}
+
+// Cookies returns the cookies set by the target website, as returned by ZenRows in the Zr-Cookies header.
+//
+// The header can hold several cookies, each optionally followed by its Set-Cookie attributes,
+// e.g. "session=abc; Path=/; HttpOnly; theme=dark".
+func (r *Response) Cookies() []*http.Cookie {
+	var lines []string
+	for _, name := range cookieHeaders {
+		for _, value := range r.Header.Values(name) {
+			lines = append(lines, splitCookies(value)...)
+		}
+	}
+	return (&http.Response{Header: http.Header{"Set-Cookie": lines}}).Cookies()
+}
+
+// splitCookies splits a header holding several cookies into Set-Cookie lines.
+func splitCookies(value string) []string {
+	var lines []string
+	var current []string
+	for _, part := range strings.Split(value, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, _, _ := strings.Cut(part, "=")
+		if _, ok := cookieAttributes[strings.ToLower(strings.TrimSpace(name))]; ok && len(current) > 0 {
+			current = append(current, part)
+			continue
+		}
+		if len(current) > 0 {
+			lines = append(lines, strings.Join(current, "; "))
+		}
+		current = []string{part}
+	}
+	if len(current) > 0 {
+		lines = append(lines, strings.Join(current, "; "))
+	}
+	return lines
+}
+
+// WithCookies Gives every new Session its own cookie jar, see Session.SetCookieJar
+func (m *SessionManager) WithCookies() *SessionManager {
+	m.cookies = true
+	return m
+}
+
+// SetCookieJar Makes the session carry cookies between scrapes: the cookies returned by ZenRows are stored
+// in the jar, and the ones matching the target URL are sent as custom headers on the next scrapes.
+// A nil jar disables cookie handling.
+func (s *Session) SetCookieJar(jar http.CookieJar) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jar = jar
+}
+
+// CookieJar returns the cookie jar of the session, nil when cookies are not handled.
+func (s *Session) CookieJar() http.CookieJar {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.jar
+}
+
+// withCookies adds the jar cookies matching the target URL to the call, enabling custom headers.
+func withCookies(jar http.CookieJar, call *Call) {
+	u, err := url.Parse(call.TargetURL)
+	if err != nil {
+		return
+	}
+	cookies := jar.Cookies(u)
+	if len(cookies) == 0 {
+		return
+	}
+
+	pairs := make([]string, 0, len(cookies)+1)
+	if existing := call.Header.Get("Cookie"); existing != "" {
+		pairs = append(pairs, existing)
+	}
+	for _, cookie := range cookies {
+		pairs = append(pairs, cookie.Name+"="+cookie.Value)
+	}
+
+	call.Header = call.Header.Clone()
+	if call.Header == nil {
+		call.Header = http.Header{}
+	}
+	call.Header.Set("Cookie", strings.Join(pairs, "; "))
+	call.Options = append(call.Options, WithCustomHeaders(true))
+}
+
+// storeCookies saves the cookies of the response in the jar.
+func storeCookies(jar http.CookieJar, targetURL string, resp *Response) {
+	cookies := resp.Cookies()
+	if len(cookies) == 0 {
+		return
+	}
+	if u, err := url.Parse(targetURL); err == nil {
+		jar.SetCookies(u, cookies)
+	}
+}
+
+func newCookieJar() http.CookieJar {
+	// The public suffix list keeps a website from setting cookies for a whole suffix, e.g. .co.uk,
+	// which the session would then send to unrelated websites. cookiejar.New never fails.
+	jar, _ := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+	return jar
+}
@@ -0,0 +1,129 @@
+package zenrows_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	"github.com/renatoaraujo/go-zenrows/zenrowstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseCookies(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   http.Header
+		expected map[string]string
+	}{
+		{"no cookies", http.Header{}, map[string]string{}},
+		{
+			"single cookie with attributes",
+			http.Header{"Zr-Cookies": {"session=abc; Path=/; HttpOnly"}},
+			map[string]string{"session": "abc"},
+		},
+		{
+			"several cookies in one header",
+			http.Header{"Zr-Cookies": {"session=abc; Path=/; Expires=Wed, 21 Oct 2037 07:28:00 GMT; theme=dark; Secure"}},
+			map[string]string{"session": "abc", "theme": "dark"},
+		},
+		{
+			"set-cookie style header",
+			http.Header{"Zr-Set-Cookie": {"a=1; Domain=example.com", "b=2"}},
+			map[string]string{"a": "1", "b": "2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &zenrows.Response{Header: tt.header}
+			got := map[string]string{}
+			for _, cookie := range resp.Cookies() {
+				got[cookie.Name] = cookie.Value
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestSessionCookies(t *testing.T) {
+	srv := zenrowstest.NewServer("key")
+	defer srv.Close()
+	srv.Handle("https://example.com/login", zenrowstest.Fixture{
+		Header: http.Header{"Zr-Cookies": {"session=abc; Path=/; HttpOnly"}},
+		Body:   "ok",
+	})
+	srv.HandleDefault(zenrowstest.Fixture{Body: "ok"})
+
+	session, err := zenrows.NewSessionManager(srv.Client()).WithCookies().NewSession()
+	require.NoError(t, err)
+	defer session.Close()
+	require.NotNil(t, session.CookieJar())
+
+	ctx := context.Background()
+	_, err = session.Scrape(ctx, "https://example.com/login")
+	require.NoError(t, err)
+	_, err = session.Execute(ctx, &zenrows.Call{
+		TargetURL: "https://example.com/account",
+		Header:    http.Header{"Cookie": {"lang=en"}},
+	})
+	require.NoError(t, err)
+	_, err = session.Scrape(ctx, "https://other.com/")
+	require.NoError(t, err)
+
+	requests := srv.Requests()
+	require.Len(t, requests, 3)
+	assert.Empty(t, requests[0].Header.Get("Cookie"))
+	assert.Equal(t, "lang=en; session=abc", requests[1].Header.Get("Cookie"))
+	require.NotNil(t, requests[1].Options.CustomHeaders)
+	assert.True(t, *requests[1].Options.CustomHeaders)
+	assert.Empty(t, requests[2].Header.Get("Cookie"))
+	assert.Nil(t, requests[2].Options.CustomHeaders)
+}
+
+func TestSessionWithoutCookies(t *testing.T) {
+	srv := zenrowstest.NewServer("key")
+	defer srv.Close()
+	srv.HandleDefault(zenrowstest.Fixture{
+		Header: http.Header{"Zr-Cookies": {"session=abc"}},
+		Body:   "ok",
+	})
+
+	session, err := zenrows.NewSessionManager(srv.Client()).NewSession()
+	require.NoError(t, err)
+	defer session.Close()
+	assert.Nil(t, session.CookieJar())
+
+	for i := 0; i < 2; i++ {
+		_, err = session.Scrape(context.Background(), "https://example.com")
+		require.NoError(t, err)
+	}
+	assert.Empty(t, srv.Requests()[1].Header.Get("Cookie"))
+}
+
+func TestSessionCookiesPublicSuffix(t *testing.T) {
+	srv := zenrowstest.NewServer("key")
+	defer srv.Close()
+	srv.Handle("https://shop.co.uk/", zenrowstest.Fixture{
+		Header: http.Header{"Zr-Cookies": {"tracker=abc; Domain=co.uk; Path=/", "lang=en; Domain=shop.co.uk; Path=/"}},
+		Body:   "ok",
+	})
+	srv.HandleDefault(zenrowstest.Fixture{Body: "ok"})
+
+	session, err := zenrows.NewSessionManager(srv.Client()).WithCookies().NewSession()
+	require.NoError(t, err)
+	defer session.Close()
+
+	ctx := context.Background()
+	for _, target := range []string{"https://shop.co.uk/", "https://bank.co.uk/", "https://www.shop.co.uk/"} {
+		_, err = session.Scrape(ctx, target)
+		require.NoError(t, err)
+	}
+
+	requests := srv.Requests()
+	require.Len(t, requests, 3)
+	assert.Empty(t, requests[1].Header.Get("Cookie"))
+	assert.Equal(t, "lang=en", requests[2].Header.Get("Cookie"))
+}
@@ -0,0 +1,521 @@
+package zenrows
+
+import "strings"
+
+// Country is an ISO 3166-1 alpha-2 country code used to geolocate premium proxies, see WithProxyCountryCode.
+//
+// The constants cover every ISO 3166-1 alpha-2 code rather than the countries ZenRows has proxies in, which change
+// over time; ZenRows rejects the countries it doesn't support, e.g. CountryAQ, when the request is made.
+type Country string
+
+// Country codes of the ISO 3166-1 alpha-2 standard, the values of the proxy_country parameter.
+const (
+	CountryAD Country = "ad" // Andorra
+	CountryAE Country = "ae" // United Arab Emirates
+	CountryAF Country = "af" // Afghanistan
+	CountryAG Country = "ag" // Antigua & Barbuda
+	CountryAI Country = "ai" // Anguilla
+	CountryAL Country = "al" // Albania
+	CountryAM Country = "am" // Armenia
+	CountryAO Country = "ao" // Angola
+	CountryAQ Country = "aq" // Antarctica
+	CountryAR Country = "ar" // Argentina
+	CountryAS Country = "as" // Samoa (American)
+	CountryAT Country = "at" // Austria
+	CountryAU Country = "au" // Australia
+	CountryAW Country = "aw" // Aruba
+	CountryAX Country = "ax" // Åland Islands
+	CountryAZ Country = "az" // Azerbaijan
+	CountryBA Country = "ba" // Bosnia & Herzegovina
+	CountryBB Country = "bb" // Barbados
+	CountryBD Country = "bd" // Bangladesh
+	CountryBE Country = "be" // Belgium
+	CountryBF Country = "bf" // Burkina Faso
+	CountryBG Country = "bg" // Bulgaria
+	CountryBH Country = "bh" // Bahrain
+	CountryBI Country = "bi" // Burundi
+	CountryBJ Country = "bj" // Benin
+	CountryBL Country = "bl" // St Barthelemy
+	CountryBM Country = "bm" // Bermuda
+	CountryBN Country = "bn" // Brunei
+	CountryBO Country = "bo" // Bolivia
+	CountryBQ Country = "bq" // Caribbean NL
+	CountryBR Country = "br" // Brazil
+	CountryBS Country = "bs" // Bahamas
+	CountryBT Country = "bt" // Bhutan
+	CountryBV Country = "bv" // Bouvet Island
+	CountryBW Country = "bw" // Botswana
+	CountryBY Country = "by" // Belarus
+	CountryBZ Country = "bz" // Belize
+	CountryCA Country = "ca" // Canada
+	CountryCC Country = "cc" // Cocos (Keeling) Islands
+	CountryCD Country = "cd" // Congo (Dem. Rep.)
+	CountryCF Country = "cf" // Central African Rep.
+	CountryCG Country = "cg" // Congo (Rep.)
+	CountryCH Country = "ch" // Switzerland
+	CountryCI Country = "ci" // Côte d'Ivoire
+	CountryCK Country = "ck" // Cook Islands
+	CountryCL Country = "cl" // Chile
+	CountryCM Country = "cm" // Cameroon
+	CountryCN Country = "cn" // China
+	CountryCO Country = "co" // Colombia
+	CountryCR Country = "cr" // Costa Rica
+	CountryCU Country = "cu" // Cuba
+	CountryCV Country = "cv" // Cape Verde
+	CountryCW Country = "cw" // Curaçao
+	CountryCX Country = "cx" // Christmas Island
+	CountryCY Country = "cy" // Cyprus
+	CountryCZ Country = "cz" // Czech Republic
+	CountryDE Country = "de" // Germany
+	CountryDJ Country = "dj" // Djibouti
+	CountryDK Country = "dk" // Denmark
+	CountryDM Country = "dm" // Dominica
+	CountryDO Country = "do" // Dominican Republic
+	CountryDZ Country = "dz" // Algeria
+	CountryEC Country = "ec" // Ecuador
+	CountryEE Country = "ee" // Estonia
+	CountryEG Country = "eg" // Egypt
+	CountryEH Country = "eh" // Western Sahara
+	CountryER Country = "er" // Eritrea
+	CountryES Country = "es" // Spain
+	CountryET Country = "et" // Ethiopia
+	CountryFI Country = "fi" // Finland
+	CountryFJ Country = "fj" // Fiji
+	CountryFK Country = "fk" // Falkland Islands
+	CountryFM Country = "fm" // Micronesia
+	CountryFO Country = "fo" // Faroe Islands
+	CountryFR Country = "fr" // France
+	CountryGA Country = "ga" // Gabon
+	CountryGB Country = "gb" // Britain (UK)
+	CountryGD Country = "gd" // Grenada
+	CountryGE Country = "ge" // Georgia
+	CountryGF Country = "gf" // French Guiana
+	CountryGG Country = "gg" // Guernsey
+	CountryGH Country = "gh" // Ghana
+	CountryGI Country = "gi" // Gibraltar
+	CountryGL Country = "gl" // Greenland
+	CountryGM Country = "gm" // Gambia
+	CountryGN Country = "gn" // Guinea
+	CountryGP Country = "gp" // Guadeloupe
+	CountryGQ Country = "gq" // Equatorial Guinea
+	CountryGR Country = "gr" // Greece
+	CountryGS Country = "gs" // South Georgia & the South Sandwich Islands
+	CountryGT Country = "gt" // Guatemala
+	CountryGU Country = "gu" // Guam
+	CountryGW Country = "gw" // Guinea-Bissau
+	CountryGY Country = "gy" // Guyana
+	CountryHK Country = "hk" // Hong Kong
+	CountryHM Country = "hm" // Heard Island & McDonald Islands
+	CountryHN Country = "hn" // Honduras
+	CountryHR Country = "hr" // Croatia
+	CountryHT Country = "ht" // Haiti
+	CountryHU Country = "hu" // Hungary
+	CountryID Country = "id" // Indonesia
+	CountryIE Country = "ie" // Ireland
+	CountryIL Country = "il" // Israel
+	CountryIM Country = "im" // Isle of Man
+	CountryIN Country = "in" // India
+	CountryIO Country = "io" // British Indian Ocean Territory
+	CountryIQ Country = "iq" // Iraq
+	CountryIR Country = "ir" // Iran
+	CountryIS Country = "is" // Iceland
+	CountryIT Country = "it" // Italy
+	CountryJE Country = "je" // Jersey
+	CountryJM Country = "jm" // Jamaica
+	CountryJO Country = "jo" // Jordan
+	CountryJP Country = "jp" // Japan
+	CountryKE Country = "ke" // Kenya
+	CountryKG Country = "kg" // Kyrgyzstan
+	CountryKH Country = "kh" // Cambodia
+	CountryKI Country = "ki" // Kiribati
+	CountryKM Country = "km" // Comoros
+	CountryKN Country = "kn" // St Kitts & Nevis
+	CountryKP Country = "kp" // Korea (North)
+	CountryKR Country = "kr" // Korea (South)
+	CountryKW Country = "kw" // Kuwait
+	CountryKY Country = "ky" // Cayman Islands
+	CountryKZ Country = "kz" // Kazakhstan
+	CountryLA Country = "la" // Laos
+	CountryLB Country = "lb" // Lebanon
+	CountryLC Country = "lc" // St Lucia
+	CountryLI Country = "li" // Liechtenstein
+	CountryLK Country = "lk" // Sri Lanka
+	CountryLR Country = "lr" // Liberia
+	CountryLS Country = "ls" // Lesotho
+	CountryLT Country = "lt" // Lithuania
+	CountryLU Country = "lu" // Luxembourg
+	CountryLV Country = "lv" // Latvia
+	CountryLY Country = "ly" // Libya
+	CountryMA Country = "ma" // Morocco
+	CountryMC Country = "mc" // Monaco
+	CountryMD Country = "md" // Moldova
+	CountryME Country = "me" // Montenegro
+	CountryMF Country = "mf" // St Martin (French)
+	CountryMG Country = "mg" // Madagascar
+	CountryMH Country = "mh" // Marshall Islands
+	CountryMK Country = "mk" // North Macedonia
+	CountryML Country = "ml" // Mali
+	CountryMM Country = "mm" // Myanmar (Burma)
+	CountryMN Country = "mn" // Mongolia
+	CountryMO Country = "mo" // Macau
+	CountryMP Country = "mp" // Northern Mariana Islands
+	CountryMQ Country = "mq" // Martinique
+	CountryMR Country = "mr" // Mauritania
+	CountryMS Country = "ms" // Montserrat
+	CountryMT Country = "mt" // Malta
+	CountryMU Country = "mu" // Mauritius
+	CountryMV Country = "mv" // Maldives
+	CountryMW Country = "mw" // Malawi
+	CountryMX Country = "mx" // Mexico
+	CountryMY Country = "my" // Malaysia
+	CountryMZ Country = "mz" // Mozambique
+	CountryNA Country = "na" // Namibia
+	CountryNC Country = "nc" // New Caledonia
+	CountryNE Country = "ne" // Niger
+	CountryNF Country = "nf" // Norfolk Island
+	CountryNG Country = "ng" // Nigeria
+	CountryNI Country = "ni" // Nicaragua
+	CountryNL Country = "nl" // Netherlands
+	CountryNO Country = "no" // Norway
+	CountryNP Country = "np" // Nepal
+	CountryNR Country = "nr" // Nauru
+	CountryNU Country = "nu" // Niue
+	CountryNZ Country = "nz" // New Zealand
+	CountryOM Country = "om" // Oman
+	CountryPA Country = "pa" // Panama
+	CountryPE Country = "pe" // Peru
+	CountryPF Country = "pf" // French Polynesia
+	CountryPG Country = "pg" // Papua New Guinea
+	CountryPH Country = "ph" // Philippines
+	CountryPK Country = "pk" // Pakistan
+	CountryPL Country = "pl" // Poland
+	CountryPM Country = "pm" // St Pierre & Miquelon
+	CountryPN Country = "pn" // Pitcairn
+	CountryPR Country = "pr" // Puerto Rico
+	CountryPS Country = "ps" // Palestine
+	CountryPT Country = "pt" // Portugal
+	CountryPW Country = "pw" // Palau
+	CountryPY Country = "py" // Paraguay
+	CountryQA Country = "qa" // Qatar
+	CountryRE Country = "re" // Réunion
+	CountryRO Country = "ro" // Romania
+	CountryRS Country = "rs" // Serbia
+	CountryRU Country = "ru" // Russia
+	CountryRW Country = "rw" // Rwanda
+	CountrySA Country = "sa" // Saudi Arabia
+	CountrySB Country = "sb" // Solomon Islands
+	CountrySC Country = "sc" // Seychelles
+	CountrySD Country = "sd" // Sudan
+	CountrySE Country = "se" // Sweden
+	CountrySG Country = "sg" // Singapore
+	CountrySH Country = "sh" // St Helena
+	CountrySI Country = "si" // Slovenia
+	CountrySJ Country = "sj" // Svalbard & Jan Mayen
+	CountrySK Country = "sk" // Slovakia
+	CountrySL Country = "sl" // Sierra Leone
+	CountrySM Country = "sm" // San Marino
+	CountrySN Country = "sn" // Senegal
+	CountrySO Country = "so" // Somalia
+	CountrySR Country = "sr" // Suriname
+	CountrySS Country = "ss" // South Sudan
+	CountryST Country = "st" // Sao Tome & Principe
+	CountrySV Country = "sv" // El Salvador
+	CountrySX Country = "sx" // St Maarten (Dutch)
+	CountrySY Country = "sy" // Syria
+	CountrySZ Country = "sz" // Eswatini (Swaziland)
+	CountryTC Country = "tc" // Turks & Caicos Is
+	CountryTD Country = "td" // Chad
+	CountryTF Country = "tf" // French S. Terr.
+	CountryTG Country = "tg" // Togo
+	CountryTH Country = "th" // Thailand
+	CountryTJ Country = "tj" // Tajikistan
+	CountryTK Country = "tk" // Tokelau
+	CountryTL Country = "tl" // East Timor
+	CountryTM Country = "tm" // Turkmenistan
+	CountryTN Country = "tn" // Tunisia
+	CountryTO Country = "to" // Tonga
+	CountryTR Country = "tr" // Turkey
+	CountryTT Country = "tt" // Trinidad & Tobago
+	CountryTV Country = "tv" // Tuvalu
+	CountryTW Country = "tw" // Taiwan
+	CountryTZ Country = "tz" // Tanzania
+	CountryUA Country = "ua" // Ukraine
+	CountryUG Country = "ug" // Uganda
+	CountryUM Country = "um" // US minor outlying islands
+	CountryUS Country = "us" // United States
+	CountryUY Country = "uy" // Uruguay
+	CountryUZ Country = "uz" // Uzbekistan
+	CountryVA Country = "va" // Vatican City
+	CountryVC Country = "vc" // St Vincent
+	CountryVE Country = "ve" // Venezuela
+	CountryVG Country = "vg" // Virgin Islands (UK)
+	CountryVI Country = "vi" // Virgin Islands (US)
+	CountryVN Country = "vn" // Vietnam
+	CountryVU Country = "vu" // Vanuatu
+	CountryWF Country = "wf" // Wallis & Futuna
+	CountryWS Country = "ws" // Samoa (western)
+	CountryYE Country = "ye" // Yemen
+	CountryYT Country = "yt" // Mayotte
+	CountryZA Country = "za" // South Africa
+	CountryZM Country = "zm" // Zambia
+	CountryZW Country = "zw" // Zimbabwe
+)
+
+var countries = map[Country]struct{}{
+	CountryAD: {},
+	CountryAE: {},
+	CountryAF: {},
+	CountryAG: {},
+	CountryAI: {},
+	CountryAL: {},
+	CountryAM: {},
+	CountryAO: {},
+	CountryAQ: {},
+	CountryAR: {},
+	CountryAS: {},
+	CountryAT: {},
+	CountryAU: {},
+	CountryAW: {},
+	CountryAX: {},
+	CountryAZ: {},
+	CountryBA: {},
+	CountryBB: {},
+	CountryBD: {},
+	CountryBE: {},
+	CountryBF: {},
+	CountryBG: {},
+	CountryBH: {},
+	CountryBI: {},
+	CountryBJ: {},
+	CountryBL: {},
+	CountryBM: {},
+	CountryBN: {},
+	CountryBO: {},
+	CountryBQ: {},
+	CountryBR: {},
+	CountryBS: {},
+	CountryBT: {},
+	CountryBV: {},
+	CountryBW: {},
+	CountryBY: {},
+	CountryBZ: {},
+	CountryCA: {},
+	CountryCC: {},
+	CountryCD: {},
+	CountryCF: {},
+	CountryCG: {},
+	CountryCH: {},
+	CountryCI: {},
+	CountryCK: {},
+	CountryCL: {},
+	CountryCM: {},
+	CountryCN: {},
+	CountryCO: {},
+	CountryCR: {},
+	CountryCU: {},
+	CountryCV: {},
+	CountryCW: {},
+	CountryCX: {},
+	CountryCY: {},
+	CountryCZ: {},
+	CountryDE: {},
+	CountryDJ: {},
+	CountryDK: {},
+	CountryDM: {},
+	CountryDO: {},
+	CountryDZ: {},
+	CountryEC: {},
+	CountryEE: {},
+	CountryEG: {},
+	CountryEH: {},
+	CountryER: {},
+	CountryES: {},
+	CountryET: {},
+	CountryFI: {},
+	CountryFJ: {},
+	CountryFK: {},
+	CountryFM: {},
+	CountryFO: {},
+	CountryFR: {},
+	CountryGA: {},
+	CountryGB: {},
+	CountryGD: {},
+	CountryGE: {},
+	CountryGF: {},
+	CountryGG: {},
+	CountryGH: {},
+	CountryGI: {},
+	CountryGL: {},
+	CountryGM: {},
+	CountryGN: {},
+	CountryGP: {},
+	CountryGQ: {},
+	CountryGR: {},
+	CountryGS: {},
+	CountryGT: {},
+	CountryGU: {},
+	CountryGW: {},
+	CountryGY: {},
+	CountryHK: {},
+	CountryHM: {},
+	CountryHN: {},
+	CountryHR: {},
+	CountryHT: {},
+	CountryHU: {},
+	CountryID: {},
+	CountryIE: {},
+	CountryIL: {},
+	CountryIM: {},
+	CountryIN: {},
+	CountryIO: {},
+	CountryIQ: {},
+	CountryIR: {},
+	CountryIS: {},
+	CountryIT: {},
+	CountryJE: {},
+	CountryJM: {},
+	CountryJO: {},
+	CountryJP: {},
+	CountryKE: {},
+	CountryKG: {},
+	CountryKH: {},
+	CountryKI: {},
+	CountryKM: {},
+	CountryKN: {},
+	CountryKP: {},
+	CountryKR: {},
+	CountryKW: {},
+	CountryKY: {},
+	CountryKZ: {},
+	CountryLA: {},
+	CountryLB: {},
+	CountryLC: {},
+	CountryLI: {},
+	CountryLK: {},
+	CountryLR: {},
+	CountryLS: {},
+	CountryLT: {},
+	CountryLU: {},
+	CountryLV: {},
+	CountryLY: {},
+	CountryMA: {},
+	CountryMC: {},
+	CountryMD: {},
+	CountryME: {},
+	CountryMF: {},
+	CountryMG: {},
+	CountryMH: {},
+	CountryMK: {},
+	CountryML: {},
+	CountryMM: {},
+	CountryMN: {},
+	CountryMO: {},
+	CountryMP: {},
+	CountryMQ: {},
+	CountryMR: {},
+	CountryMS: {},
+	CountryMT: {},
+	CountryMU: {},
+	CountryMV: {},
+	CountryMW: {},
+	CountryMX: {},
+	CountryMY: {},
+	CountryMZ: {},
+	CountryNA: {},
+	CountryNC: {},
+	CountryNE: {},
+	CountryNF: {},
+	CountryNG: {},
+	CountryNI: {},
+	CountryNL: {},
+	CountryNO: {},
+	CountryNP: {},
+	CountryNR: {},
+	CountryNU: {},
+	CountryNZ: {},
+	CountryOM: {},
+	CountryPA: {},
+	CountryPE: {},
+	CountryPF: {},
+	CountryPG: {},
+	CountryPH: {},
+	CountryPK: {},
+	CountryPL: {},
+	CountryPM: {},
+	CountryPN: {},
+	CountryPR: {},
+	CountryPS: {},
+	CountryPT: {},
+	CountryPW: {},
+	CountryPY: {},
+	CountryQA: {},
+	CountryRE: {},
+	CountryRO: {},
+	CountryRS: {},
+	CountryRU: {},
+	CountryRW: {},
+	CountrySA: {},
+	CountrySB: {},
+	CountrySC: {},
+	CountrySD: {},
+	CountrySE: {},
+	CountrySG: {},
+	CountrySH: {},
+	CountrySI: {},
+	CountrySJ: {},
+	CountrySK: {},
+	CountrySL: {},
+	CountrySM: {},
+	CountrySN: {},
+	CountrySO: {},
+	CountrySR: {},
+	CountrySS: {},
+	CountryST: {},
+	CountrySV: {},
+	CountrySX: {},
+	CountrySY: {},
+	CountrySZ: {},
+	CountryTC: {},
+	CountryTD: {},
+	CountryTF: {},
+	CountryTG: {},
+	CountryTH: {},
+	CountryTJ: {},
+	CountryTK: {},
+	CountryTL: {},
+	CountryTM: {},
+	CountryTN: {},
+	CountryTO: {},
+	CountryTR: {},
+	CountryTT: {},
+	CountryTV: {},
+	CountryTW: {},
+	CountryTZ: {},
+	CountryUA: {},
+	CountryUG: {},
+	CountryUM: {},
+	CountryUS: {},
+	CountryUY: {},
+	CountryUZ: {},
+	CountryVA: {},
+	CountryVC: {},
+	CountryVE: {},
+	CountryVG: {},
+	CountryVI: {},
+	CountryVN: {},
+	CountryVU: {},
+	CountryWF: {},
+	CountryWS: {},
+	CountryYE: {},
+	CountryYT: {},
+	CountryZA: {},
+	CountryZM: {},
+	CountryZW: {},
+}
+
+// Valid reports whether the country is a known ISO 3166-1 alpha-2 code, ignoring case.
+// It doesn't tell whether ZenRows has proxies in the country.
+func (c Country) Valid() bool {
+	_, ok := countries[Country(strings.ToLower(string(c)))]
+	return ok
+}
@@ -0,0 +1,74 @@
+package zenrows
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// PreparedRequest is the request Scrape would send to the ZenRows API, with the apikey redacted.
+type PreparedRequest struct {
+	Method string
+	URL    string
+	Header http.Header
+}
+
+// DryRun returns the request Scrape would send for the given targetURL and ScrapeOptions without sending it.
+//
+// The request goes through the same validation as Scrape and includes the client default options,
+// client middlewares are not run.
+// The apikey is redacted, so the result is safe to log or share in code reviews.
+//
+// Parameters:
+// - targetURL: The URL of the website you want to scrape.
+// - params: Optional parameters to customize the scraping process. Refer to ScrapeOptions for available options.
+func (c *Client) DryRun(targetURL string, params ...ScrapeOptions) (*PreparedRequest, error) {
+	call := &Call{TargetURL: targetURL, Options: params}
+	apiURL, err := c.prepareAPIURL(call)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(context.Background(), apiURL, call)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PreparedRequest{
+		Method: req.Method,
+		URL:    redactURL(req.URL),
+		Header: c.redactHeader(req.Header),
+	}, nil
+}
+
+// Curl renders the request as a curl command.
+func (r *PreparedRequest) Curl() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "curl -X %s %s", r.Method, shellQuote(r.URL))
+
+	names := make([]string, 0, len(r.Header))
+	for name := range r.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range r.Header[name] {
+			fmt.Fprintf(&b, " -H %s", shellQuote(name+": "+value))
+		}
+	}
+	return b.String()
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func (c *Client) redactHeader(header http.Header) http.Header {
+	header = header.Clone()
+	if c.config.keyHeader != "" && header.Get(c.config.keyHeader) != "" {
+		header.Set(c.config.keyHeader, redacted)
+	}
+	return header
+}
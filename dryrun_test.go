@@ -0,0 +1,88 @@
+package zenrows_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	mocks "github.com/renatoaraujo/go-zenrows/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		url         string
+		defaults    []zenrows.ScrapeOptions
+		options     []zenrows.ScrapeOptions
+		expected    url.Values
+		expectError bool
+	}{
+		{
+			name:     "Request without options",
+			url:      "https://example.com",
+			expected: url.Values{"apikey": []string{"REDACTED"}, "url": []string{"https://example.com"}},
+		},
+		{
+			name:     "Request with default and per-call options",
+			url:      "https://example.com",
+			defaults: []zenrows.ScrapeOptions{zenrows.WithDeviceType(zenrows.DeviceMobile)},
+			options:  []zenrows.ScrapeOptions{zenrows.WithJSRender()},
+			expected: url.Values{
+				"apikey":    []string{"REDACTED"},
+				"url":       []string{"https://example.com"},
+				"device":    []string{"mobile"},
+				"js_render": []string{"true"},
+			},
+		},
+		{
+			name:        "Invalid options",
+			url:         "https://example.com",
+			options:     []zenrows.ScrapeOptions{zenrows.WithWait(-1)},
+			expectError: true,
+		},
+		{
+			name:        "Invalid target url",
+			url:         "invalid",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := zenrows.NewClient(mocks.NewHttpClient(t), tt.defaults...).
+				WithApiKey("secret-key")
+
+			req, err := client.DryRun(tt.url, tt.options...)
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.NotContains(t, req.URL, "secret-key")
+
+			u, err := url.Parse(req.URL)
+			require.NoError(t, err)
+			assert.Equal(t, "api.zenrows.com", u.Host)
+			assert.Equal(t, tt.expected, u.Query())
+		})
+	}
+}
+
+func TestPreparedRequestCurl(t *testing.T) {
+	req := &zenrows.PreparedRequest{
+		Method: http.MethodGet,
+		URL:    "https://api.zenrows.com/v1/?apikey=REDACTED&url=https%3A%2F%2Fexample.com%2Fit's",
+		Header: http.Header{"User-Agent": []string{"test"}},
+	}
+
+	assert.Equal(t,
+		`curl -X GET 'https://api.zenrows.com/v1/?apikey=REDACTED&url=https%3A%2F%2Fexample.com%2Fit'\''s' -H 'User-Agent: test'`,
+		req.Curl(),
+	)
+}
@@ -0,0 +1,70 @@
+package zenrows
+
+// Device is the type of user agent used for the request, see WithDeviceType.
+type Device string
+
+// Devices accepted by the device parameter.
+const (
+	DeviceDesktop Device = "desktop"
+	DeviceMobile  Device = "mobile"
+)
+
+// Valid reports whether the device is one supported by ZenRows.
+func (d Device) Valid() bool {
+	return d == DeviceDesktop || d == DeviceMobile
+}
+
+// ResponseType is the format ZenRows converts the scraped page to, see WithResponseType.
+type ResponseType string
+
+// Response types accepted by the response_type parameter.
+const (
+	ResponseTypeMarkdown  ResponseType = "markdown"
+	ResponseTypePlaintext ResponseType = "plaintext"
+	ResponseTypePDF       ResponseType = "pdf"
+)
+
+// Valid reports whether the response type is one supported by ZenRows.
+func (r ResponseType) Valid() bool {
+	return r == ResponseTypeMarkdown || r == ResponseTypePlaintext || r == ResponseTypePDF
+}
+
+// ResourceType is a type of resource the headless browser can be prevented from loading, see WithBlockResourceTypes.
+type ResourceType string
+
+// Resource types accepted by the block_resources parameter.
+const (
+	ResourceStylesheet  ResourceType = "stylesheet"
+	ResourceImage       ResourceType = "image"
+	ResourceMedia       ResourceType = "media"
+	ResourceFont        ResourceType = "font"
+	ResourceScript      ResourceType = "script"
+	ResourceTextTrack   ResourceType = "texttrack"
+	ResourceXHR         ResourceType = "xhr"
+	ResourceFetch       ResourceType = "fetch"
+	ResourceEventSource ResourceType = "eventsource"
+	ResourceWebSocket   ResourceType = "websocket"
+	ResourceManifest    ResourceType = "manifest"
+	ResourceOther       ResourceType = "other"
+)
+
+var resourceTypes = map[ResourceType]struct{}{
+	ResourceStylesheet:  {},
+	ResourceImage:       {},
+	ResourceMedia:       {},
+	ResourceFont:        {},
+	ResourceScript:      {},
+	ResourceTextTrack:   {},
+	ResourceXHR:         {},
+	ResourceFetch:       {},
+	ResourceEventSource: {},
+	ResourceWebSocket:   {},
+	ResourceManifest:    {},
+	ResourceOther:       {},
+}
+
+// Valid reports whether the resource type is one supported by ZenRows.
+func (r ResourceType) Valid() bool {
+	_, ok := resourceTypes[r]
+	return ok
+}
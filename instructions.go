@@ -0,0 +1,119 @@
+package zenrows
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Instruction is a single JavaScript instruction run by ZenRows on the rendered page, see WithInstructions.
+type Instruction map[string]any
+
+// Click clicks the first element matching the CSS selector.
+func Click(selector string) Instruction {
+	return Instruction{"click": selector}
+}
+
+// Wait waits for the given number of milliseconds.
+func Wait(ms int) Instruction {
+	return Instruction{"wait": ms}
+}
+
+// WaitFor waits for an element matching the CSS selector to appear.
+func WaitFor(selector string) Instruction {
+	return Instruction{"wait_for": selector}
+}
+
+// Fill types value into the input matching the CSS selector.
+func Fill(selector, value string) Instruction {
+	return Instruction{"fill": []string{selector, value}}
+}
+
+// ScrollY scrolls the page vertically by the given number of pixels.
+func ScrollY(px int) Instruction {
+	return Instruction{"scroll_y": px}
+}
+
+// ScrollX scrolls the page horizontally by the given number of pixels.
+func ScrollX(px int) Instruction {
+	return Instruction{"scroll_x": px}
+}
+
+// Evaluate runs the JavaScript code on the page.
+func Evaluate(script string) Instruction {
+	return Instruction{"evaluate": script}
+}
+
+// ScrollToBottom scrolls to the bottom of the page, triggering infinite scroll loaders.
+func ScrollToBottom() Instruction {
+	return Evaluate("window.scrollTo(0, document.body.scrollHeight);")
+}
+
+// ClickIfPresent clicks the first element matching the CSS selector when there's one.
+// Unlike Click, it doesn't fail when the element is gone, e.g. once a "load more" button disappeared.
+func ClickIfPresent(selector string) Instruction {
+	quoted, _ := json.Marshal(selector)
+	return Evaluate(fmt.Sprintf("document.querySelector(%s)?.click();", quoted))
+}
+
+// InfiniteScroll scrolls to the bottom of the page the given number of times, waiting wait milliseconds after each
+// scroll for the new content to load.
+func InfiniteScroll(scrolls, wait int) []Instruction {
+	instructions := make([]Instruction, 0, 2*scrolls)
+	for i := 0; i < scrolls; i++ {
+		instructions = append(instructions, ScrollToBottom(), Wait(wait))
+	}
+	return instructions
+}
+
+// LoadMore clicks the "load more" element matching the CSS selector until it disappears, at most maxClicks times,
+// waiting wait milliseconds after each click for the new content to load.
+//
+// ZenRows runs a fixed list of instructions, so the clicks left once the element disappeared do nothing
+// but their waits still apply.
+func LoadMore(selector string, maxClicks, wait int) []Instruction {
+	instructions := make([]Instruction, 0, 2*maxClicks)
+	for i := 0; i < maxClicks; i++ {
+		instructions = append(instructions, ClickIfPresent(selector), Wait(wait))
+	}
+	return instructions
+}
+
+// WithInstructions sets the JavaScript instructions of the scrape request, enabling WithJSRender.
+// Unlike WithJSInstructions, the instructions are sent as they are, keeping the spaces of evaluated scripts.
+func WithInstructions(instructions ...Instruction) ScrapeOptions {
+	if instructions == nil {
+		instructions = []Instruction{}
+	}
+	encoded, err := json.Marshal(instructions)
+	return func(values url.Values) {
+		values.Set("js_render", "true")
+		if err != nil {
+			// Leave an invalid value for the validation of the request to report.
+			values.Set("js_instructions", fmt.Sprintf("invalid instructions: %v", err))
+			return
+		}
+		values.Set("js_instructions", string(encoded))
+	}
+}
+
+// WithScrollCapture scrolls to the bottom of the page the given number of times, see InfiniteScroll, and returns
+// the page as a JSON response including the XHR and Fetch requests it made, see Response.JSON.
+func WithScrollCapture(scrolls, wait int) ScrapeOptions {
+	return withCapture(InfiniteScroll(scrolls, wait))
+}
+
+// WithLoadMoreCapture clicks a "load more" element until it disappears, see LoadMore, and returns the page
+// as a JSON response including the XHR and Fetch requests it made, see Response.JSON.
+func WithLoadMoreCapture(selector string, maxClicks, wait int) ScrapeOptions {
+	return withCapture(LoadMore(selector, maxClicks, wait))
+}
+
+func withCapture(instructions []Instruction) ScrapeOptions {
+	withInstructions := WithInstructions(instructions...)
+	withJSONResponse := WithJSONResponse(true)
+	return func(values url.Values) {
+		withInstructions(values)
+		withJSONResponse(values)
+	}
+}
@@ -0,0 +1,80 @@
+package zenrows_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithInstructions(t *testing.T) {
+	tests := []struct {
+		name     string
+		option   zenrows.ScrapeOptions
+		expected url.Values
+	}{
+		{
+			"WithInstructions",
+			zenrows.WithInstructions(
+				zenrows.Fill("#q", "running shoes"),
+				zenrows.Click("button[type=submit]"),
+				zenrows.WaitFor(".results"),
+				zenrows.ScrollX(100),
+			),
+			url.Values{
+				"js_render":       {"true"},
+				"js_instructions": {`[{"fill":["#q","running shoes"]},{"click":"button[type=submit]"},{"wait_for":".results"},{"scroll_x":100}]`},
+			},
+		},
+		{
+			"WithInstructions without instructions",
+			zenrows.WithInstructions(),
+			url.Values{"js_render": {"true"}, "js_instructions": {"[]"}},
+		},
+		{
+			"WithScrollCapture",
+			zenrows.WithScrollCapture(2, 1000),
+			url.Values{
+				"js_render":     {"true"},
+				"json_response": {"true"},
+				"js_instructions": {`[{"evaluate":"window.scrollTo(0, document.body.scrollHeight);"},{"wait":1000},` +
+					`{"evaluate":"window.scrollTo(0, document.body.scrollHeight);"},{"wait":1000}]`},
+			},
+		},
+		{
+			"WithLoadMoreCapture",
+			zenrows.WithLoadMoreCapture(`button[data-action="more"]`, 1, 500),
+			url.Values{
+				"js_render":       {"true"},
+				"json_response":   {"true"},
+				"js_instructions": {`[{"evaluate":"document.querySelector(\"button[data-action=\\\"more\\\"]\")?.click();"},{"wait":500}]`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := url.Values{}
+			tt.option(values)
+			assert.Equal(t, tt.expected, values)
+			require.NoError(t, zenrows.ValidateValues(values))
+		})
+	}
+}
+
+func TestInstructionSequences(t *testing.T) {
+	assert.Equal(t, []zenrows.Instruction{
+		zenrows.ScrollToBottom(), zenrows.Wait(300),
+		zenrows.ScrollToBottom(), zenrows.Wait(300),
+		zenrows.ScrollToBottom(), zenrows.Wait(300),
+	}, zenrows.InfiniteScroll(3, 300))
+	assert.Empty(t, zenrows.InfiniteScroll(0, 300))
+
+	assert.Equal(t, []zenrows.Instruction{
+		zenrows.ClickIfPresent(".more"), zenrows.Wait(200),
+		zenrows.ClickIfPresent(".more"), zenrows.Wait(200),
+	}, zenrows.LoadMore(".more", 2, 200))
+}
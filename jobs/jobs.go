@@ -0,0 +1,107 @@
+// Package jobs defines a stable on-disk format for scrape jobs and their results, and a Runner
+// processing jobs through a zenrows.Client.
+//
+// Jobs and results are stored as newline delimited JSON (NDJSON), one value per line, following the
+// JSON schemas in JobSchema and ResultSchema:
+//
+//	{"id":"home","url":"https://example.com","options":{"js_render":true},"tags":["daily"]}
+//	{"id":"home","url":"https://example.com","status":200,"body":"<html>...</html>","cost":5}
+package jobs
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/renatoaraujo/go-zenrows"
+)
+
+// JobSchema is the JSON schema of a Job.
+//
+//go:embed schema/job.schema.json
+var JobSchema []byte
+
+// ResultSchema is the JSON schema of a Result.
+//
+//go:embed schema/result.schema.json
+var ResultSchema []byte
+
+// Job is a scrape to be made.
+type Job struct {
+	// ID identifies the job, it's copied to its Result.
+	ID string `json:"id,omitempty"`
+	// TargetURL is the URL of the website to scrape.
+	TargetURL string `json:"url"`
+	// Method is the HTTP method of the scrape, only GET is supported for now. Empty means GET.
+	Method string `json:"method,omitempty"`
+	// Options are the scrape options.
+	Options zenrows.ScrapeRequest `json:"options"`
+	// Tags are free-form labels copied to the Result.
+	Tags []string `json:"tags,omitempty"`
+	// Metadata is free-form data copied to the Result.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// Key identifies the job in a Store: its ID, or a digest of its URL and options when it has none.
+func (j Job) Key() string {
+	if j.ID != "" {
+		return j.ID
+	}
+	sum := sha256.Sum256([]byte(j.TargetURL + "\n" + j.Options.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// Validate checks the job can be run.
+func (j Job) Validate() error {
+	var errs []error
+	if j.TargetURL == "" {
+		errs = append(errs, errors.New("url is required"))
+	} else if u, err := url.Parse(j.TargetURL); err != nil || u.Scheme == "" || u.Host == "" {
+		errs = append(errs, fmt.Errorf("url %q is not a full URL", j.TargetURL))
+	}
+	if j.Method != "" && j.Method != http.MethodGet {
+		errs = append(errs, fmt.Errorf("method %q is not supported, only GET is", j.Method))
+	}
+	if err := j.Options.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Result is the outcome of a Job.
+type Result struct {
+	// JobID is the ID of the job.
+	JobID string `json:"id,omitempty"`
+	// TargetURL is the URL of the job.
+	TargetURL string `json:"url"`
+	// Status is the status returned by ZenRows, zero when the scrape failed.
+	Status int `json:"status,omitempty"`
+	// Header contains the headers returned by ZenRows.
+	Header http.Header `json:"header,omitempty"`
+	// Body is the scraped content, unless it's stored in BodyFile.
+	Body string `json:"body,omitempty"`
+	// BodyFile is the path of the file holding the scraped content, relative to the Runner BodyDir.
+	BodyFile string `json:"body_file,omitempty"`
+	// Cost is the amount of credits spent, when reported by ZenRows.
+	Cost float64 `json:"cost,omitempty"`
+	// Error describes why the scrape failed.
+	Error string `json:"error,omitempty"`
+	// StartedAt is when the scrape started.
+	StartedAt time.Time `json:"started_at"`
+	// DurationMS is the time spent on the scrape in milliseconds.
+	DurationMS int64 `json:"duration_ms"`
+	// Tags are the tags of the job.
+	Tags []string `json:"tags,omitempty"`
+	// Metadata is the metadata of the job.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// Failed reports whether the scrape failed or ZenRows returned an error status.
+func (r Result) Failed() bool {
+	return r.Error != "" || r.Status >= http.StatusBadRequest
+}
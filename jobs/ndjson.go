@@ -0,0 +1,122 @@
+package jobs
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/renatoaraujo/go-zenrows"
+)
+
+const maxLineSize = 64 << 20
+
+// Reader streams values from NDJSON, one per line. Empty lines are skipped.
+type Reader[T any] struct {
+	scanner *bufio.Scanner
+	line    int
+	// decode parses a line, it's nil for NDJSON.
+	decode func(line []byte) (T, error)
+	// comments makes the lines starting with # skipped.
+	comments bool
+}
+
+// NewJobReader creates a Reader of jobs, every job is validated when read.
+func NewJobReader(r io.Reader) *Reader[Job] {
+	return newReader[Job](r)
+}
+
+// NewResultReader creates a Reader of results.
+func NewResultReader(r io.Reader) *Reader[Result] {
+	return newReader[Result](r)
+}
+
+// NewURLReader creates a Reader of jobs from plain text, one target URL per line, all scraped with the given options.
+// Lines starting with # are skipped. The jobs have no ID, so their Key is derived from the URL and the options.
+func NewURLReader(r io.Reader, options zenrows.ScrapeRequest) *Reader[Job] {
+	reader := newReader[Job](r)
+	reader.comments = true
+	reader.decode = func(line []byte) (Job, error) {
+		return Job{TargetURL: string(line), Options: options}, nil
+	}
+	return reader
+}
+
+func newReader[T any](r io.Reader) *Reader[T] {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	return &Reader[T]{scanner: scanner}
+}
+
+// Next returns the next value, or io.EOF when there are no values left.
+func (r *Reader[T]) Next() (T, error) {
+	var v T
+	for r.scanner.Scan() {
+		r.line++
+		line := bytes.TrimSpace(r.scanner.Bytes())
+		if len(line) == 0 || r.comments && line[0] == '#' {
+			continue
+		}
+
+		if r.decode != nil {
+			var err error
+			if v, err = r.decode(line); err != nil {
+				return v, fmt.Errorf("line %d: %w", r.line, err)
+			}
+		} else {
+			decoder := json.NewDecoder(bytes.NewReader(line))
+			decoder.DisallowUnknownFields()
+			if err := decoder.Decode(&v); err != nil {
+				return v, fmt.Errorf("line %d: %w", r.line, err)
+			}
+		}
+		if validator, ok := any(v).(interface{ Validate() error }); ok {
+			if err := validator.Validate(); err != nil {
+				return v, fmt.Errorf("line %d: %w", r.line, err)
+			}
+		}
+		return v, nil
+	}
+	if err := r.scanner.Err(); err != nil {
+		return v, fmt.Errorf("line %d: %w", r.line+1, err)
+	}
+	return v, io.EOF
+}
+
+// Line returns the line number of the last value returned by Next.
+func (r *Reader[T]) Line() int {
+	return r.line
+}
+
+// Writer writes values as NDJSON, one per line. It's safe for concurrent use.
+type Writer[T any] struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewJobWriter creates a Writer of jobs.
+func NewJobWriter(w io.Writer) *Writer[Job] {
+	return &Writer[Job]{w: w}
+}
+
+// NewResultWriter creates a Writer of results.
+func NewResultWriter(w io.Writer) *Writer[Result] {
+	return &Writer[Result]{w: w}
+}
+
+// Write writes v as a single line.
+func (w *Writer[T]) Write(v T) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode: %w", err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, err := w.w.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package jobs_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	"github.com/renatoaraujo/go-zenrows/jobs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		job         jobs.Job
+		expectError bool
+	}{
+		{"Valid job", jobs.Job{TargetURL: "https://example.com", Method: "GET"}, false},
+		{"Missing url", jobs.Job{}, true},
+		{"Relative url", jobs.Job{TargetURL: "/page"}, true},
+		{"Unsupported method", jobs.Job{TargetURL: "https://example.com", Method: "POST"}, true},
+		{"Invalid options", jobs.Job{TargetURL: "https://example.com", Options: zenrows.ScrapeRequest{Device: "tablet"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.job.Validate()
+			if tt.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJobReader(t *testing.T) {
+	input := `{"id":"a","url":"https://example.com/a","options":{"js_render":true},"tags":["daily"],"metadata":{"team":"news"}}
+
+{"id":"b","url":"https://example.com/b","method":"POST"}
+{"id":"c","url":"https://example.com/c","unknown":true}
+{"id":"d","url":"https://example.com/d"}
+`
+	reader := jobs.NewJobReader(strings.NewReader(input))
+
+	job, err := reader.Next()
+	require.NoError(t, err)
+	assert.Equal(t, jobs.Job{
+		ID:        "a",
+		TargetURL: "https://example.com/a",
+		Options:   zenrows.ScrapeRequest{JSRender: zenrows.Bool(true)},
+		Tags:      []string{"daily"},
+		Metadata:  map[string]string{"team": "news"},
+	}, job)
+	assert.Equal(t, 1, reader.Line())
+
+	job, err = reader.Next()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "line 3")
+	assert.Equal(t, "b", job.ID)
+
+	_, err = reader.Next()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "line 4")
+
+	job, err = reader.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "d", job.ID)
+
+	_, err = reader.Next()
+	assert.True(t, errors.Is(err, io.EOF))
+}
+
+func TestURLReader(t *testing.T) {
+	options := zenrows.ScrapeRequest{Device: "mobile"}
+	reader := jobs.NewURLReader(strings.NewReader("https://example.com/a\n\n# comment\n  not-a-url  \n"), options)
+
+	job, err := reader.Next()
+	require.NoError(t, err)
+	assert.Equal(t, jobs.Job{TargetURL: "https://example.com/a", Options: options}, job)
+
+	job, err = reader.Next()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "line 4")
+	assert.Equal(t, "not-a-url", job.TargetURL)
+
+	_, err = reader.Next()
+	assert.True(t, errors.Is(err, io.EOF))
+}
+
+func TestResultRoundTrip(t *testing.T) {
+	results := []jobs.Result{
+		{JobID: "a", TargetURL: "https://example.com/a", Status: 200, Body: "page a", Cost: 5, DurationMS: 120},
+		{JobID: "b", TargetURL: "https://example.com/b", Error: "failed to make request"},
+	}
+
+	var buf bytes.Buffer
+	writer := jobs.NewResultWriter(&buf)
+	for _, result := range results {
+		require.NoError(t, writer.Write(result))
+	}
+	assert.Equal(t, 2, strings.Count(buf.String(), "\n"))
+
+	reader := jobs.NewResultReader(&buf)
+	for _, expected := range results {
+		result, err := reader.Next()
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+	}
+	_, err := reader.Next()
+	assert.True(t, errors.Is(err, io.EOF))
+
+	assert.False(t, results[0].Failed())
+	assert.True(t, results[1].Failed())
+}
+
+func TestSchemas(t *testing.T) {
+	for name, schema := range map[string][]byte{"job": jobs.JobSchema, "result": jobs.ResultSchema} {
+		var v map[string]any
+		require.NoError(t, json.Unmarshal(schema, &v), name)
+		assert.Equal(t, "object", v["type"], name)
+	}
+}
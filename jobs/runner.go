@@ -0,0 +1,228 @@
+package jobs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/renatoaraujo/go-zenrows"
+)
+
+// DefaultConcurrency is the number of concurrent scrapes of a Runner unless configured otherwise.
+const DefaultConcurrency = 5
+
+// Runner processes jobs through a zenrows.Client.
+type Runner struct {
+	// Client makes the scrapes.
+	Client *zenrows.Client
+	// Concurrency is the number of concurrent scrapes, DefaultConcurrency when zero.
+	Concurrency int
+	// BodyDir, when set, is the directory the scraped content is written to instead of the Result Body.
+	BodyDir string
+	// Store, when set, tracks the progress of the run so it can be resumed: jobs already done are skipped
+	// and jobs that were in flight when the run stopped are scraped again.
+	Store *Store
+	// RetryFailed makes a resumed run scrape the jobs recorded as failed in the Store again.
+	RetryFailed bool
+	// OnResult, when set, is called with every Result once it's written, e.g. to report progress.
+	// It's called concurrently from the workers of the run.
+	OnResult func(Result)
+}
+
+// Run reads every job from jobs, scrapes it and writes its Result to results.
+//
+// With a Store, a job is marked done or failed only after its Result is written, so a crash can at worst
+// produce a duplicated Result for a job, never lose one.
+//
+// Jobs failing validation or scraping produce a Result with an Error, they don't stop the run.
+// Run returns when all jobs are processed, when reading jobs fails with something other than an invalid job,
+// when writing a result fails, or when ctx is done.
+func (r *Runner) Run(ctx context.Context, jobs *Reader[Job], results *Writer[Result]) error {
+	concurrency := r.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	queue := make(chan Job)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range queue {
+				if err := r.process(ctx, job, results); err != nil {
+					cancel(err)
+				}
+			}
+		}()
+	}
+
+	err := r.feed(ctx, jobs, results, queue)
+	close(queue)
+	wg.Wait()
+
+	if cause := context.Cause(ctx); err == nil && cause != nil && !errors.Is(cause, context.Canceled) {
+		err = cause
+	}
+	return err
+}
+
+// feed sends the jobs to the queue until they are all read or ctx is done.
+func (r *Runner) feed(ctx context.Context, jobs *Reader[Job], results *Writer[Result], queue chan<- Job) error {
+	for {
+		job, err := jobs.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil && job.TargetURL == "" && job.ID == "" {
+			return err
+		}
+
+		skip, storeErr := r.skip(job)
+		if storeErr != nil {
+			return storeErr
+		}
+		if skip {
+			continue
+		}
+
+		if err != nil {
+			result := newResult(job)
+			result.Error = err.Error()
+			if err := r.finish(job, result, results); err != nil {
+				return err
+			}
+			continue
+		}
+
+		select {
+		case queue <- job:
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		}
+	}
+}
+
+// skip reports whether the Store says the job doesn't have to run, otherwise the job is marked pending.
+func (r *Runner) skip(job Job) (bool, error) {
+	if r.Store == nil {
+		return false, nil
+	}
+	key := job.Key()
+	switch state, _ := r.Store.State(key); state {
+	case StateDone:
+		return true, nil
+	case StateFailed:
+		if !r.RetryFailed {
+			return true, nil
+		}
+	}
+	return false, r.Store.Set(key, StatePending)
+}
+
+func (r *Runner) process(ctx context.Context, job Job, results *Writer[Result]) error {
+	if r.Store != nil {
+		if err := r.Store.Set(job.Key(), StateInFlight); err != nil {
+			return err
+		}
+	}
+	result, err := r.runJob(ctx, job)
+	if err != nil && ctx.Err() != nil && errors.Is(err, ctx.Err()) {
+		// The scrape was interrupted because the run is stopping, leave the job in flight so a resumed run
+		// scrapes it again. A scrape that completed is always recorded, so it's never paid for twice.
+		return nil
+	}
+	return r.finish(job, result, results)
+}
+
+// finish writes the result and then records the final state of the job.
+func (r *Runner) finish(job Job, result Result, results *Writer[Result]) error {
+	if err := results.Write(result); err != nil {
+		return err
+	}
+	if r.OnResult != nil {
+		r.OnResult(result)
+	}
+	if r.Store == nil {
+		return nil
+	}
+	if result.Failed() {
+		reason := result.Error
+		if reason == "" {
+			reason = fmt.Sprintf("unexpected status %d", result.Status)
+		}
+		return r.Store.SetFailed(job.Key(), reason)
+	}
+	return r.Store.Set(job.Key(), StateDone)
+}
+
+// RunJob scrapes a single job.
+func (r *Runner) RunJob(ctx context.Context, job Job) Result {
+	if err := job.Validate(); err != nil {
+		result := newResult(job)
+		result.Error = err.Error()
+		return result
+	}
+	result, _ := r.runJob(ctx, job)
+	return result
+}
+
+// runJob scrapes the job, the returned error is the one of the scrape, already reported in the Result.
+func (r *Runner) runJob(ctx context.Context, job Job) (Result, error) {
+	result := newResult(job)
+
+	resp, err := r.Client.Execute(ctx, &zenrows.Call{
+		TargetURL: job.TargetURL,
+		Options:   []zenrows.ScrapeOptions{job.Options.Option()},
+	})
+	result.DurationMS = time.Since(result.StartedAt).Milliseconds()
+	if err != nil {
+		result.Error = err.Error()
+		return result, err
+	}
+
+	result.Status = resp.StatusCode
+	result.Header = resp.Header
+	if cost := resp.Cost(); cost >= 0 {
+		result.Cost = cost
+	}
+
+	if r.BodyDir == "" {
+		result.Body = resp.Body
+		return result, nil
+	}
+
+	name := bodyFileName(job)
+	if err := os.WriteFile(filepath.Join(r.BodyDir, name), []byte(resp.Body), 0o644); err != nil {
+		result.Error = fmt.Sprintf("failed to write body: %v", err)
+		return result, nil
+	}
+	result.BodyFile = name
+	return result, nil
+}
+
+func newResult(job Job) Result {
+	return Result{
+		JobID:     job.ID,
+		TargetURL: job.TargetURL,
+		Tags:      job.Tags,
+		Metadata:  job.Metadata,
+		StartedAt: time.Now().UTC(),
+	}
+}
+
+// bodyFileName derives a file name unique to the job from its ID, URL and options.
+func bodyFileName(job Job) string {
+	sum := sha256.Sum256([]byte(job.ID + "\n" + job.TargetURL + "\n" + job.Options.String()))
+	return hex.EncodeToString(sum[:16]) + ".body"
+}
@@ -0,0 +1,102 @@
+package jobs_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows/jobs"
+	"github.com/renatoaraujo/go-zenrows/zenrowstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readResults(t *testing.T, r io.Reader) map[string]jobs.Result {
+	t.Helper()
+	results := map[string]jobs.Result{}
+	reader := jobs.NewResultReader(r)
+	for {
+		result, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return results
+		}
+		require.NoError(t, err)
+		results[result.JobID] = result
+	}
+}
+
+func TestRunner(t *testing.T) {
+	srv := zenrowstest.NewServer("test-key")
+	defer srv.Close()
+	srv.Handle("https://example.com/a", zenrowstest.Fixture{Body: "page a"})
+	srv.Handle("https://example.com/b", zenrowstest.Fixture{Body: "page b"})
+
+	input := `{"id":"a","url":"https://example.com/a","options":{"js_render":true},"tags":["daily"]}
+{"id":"b","url":"https://example.com/b"}
+{"id":"c","url":"https://example.com/c"}
+{"id":"d","url":"https://example.com/d","method":"POST"}
+`
+	var output bytes.Buffer
+	runner := &jobs.Runner{Client: srv.Client(), Concurrency: 2}
+	err := runner.Run(context.Background(), jobs.NewJobReader(strings.NewReader(input)), jobs.NewResultWriter(&output))
+	require.NoError(t, err)
+
+	results := readResults(t, &output)
+	require.Len(t, results, 4)
+
+	assert.Equal(t, 200, results["a"].Status)
+	assert.Equal(t, "page a", results["a"].Body)
+	assert.Equal(t, float64(5), results["a"].Cost)
+	assert.Equal(t, []string{"daily"}, results["a"].Tags)
+	assert.False(t, results["a"].Failed())
+
+	assert.Equal(t, "page b", results["b"].Body)
+
+	assert.Equal(t, 404, results["c"].Status)
+	assert.True(t, results["c"].Failed())
+
+	assert.Contains(t, results["d"].Error, "not supported")
+	assert.Len(t, srv.Requests(), 3)
+}
+
+func TestRunnerBodyDir(t *testing.T) {
+	srv := zenrowstest.NewServer("test-key")
+	defer srv.Close()
+	srv.Handle("https://example.com/a", zenrowstest.Fixture{Body: "page a"})
+
+	dir := t.TempDir()
+	var output bytes.Buffer
+	runner := &jobs.Runner{Client: srv.Client(), BodyDir: dir}
+	err := runner.Run(context.Background(),
+		jobs.NewJobReader(strings.NewReader(`{"id":"a","url":"https://example.com/a"}`)),
+		jobs.NewResultWriter(&output),
+	)
+	require.NoError(t, err)
+
+	result := readResults(t, &output)["a"]
+	assert.Empty(t, result.Body)
+	require.NotEmpty(t, result.BodyFile)
+
+	body, err := os.ReadFile(filepath.Join(dir, result.BodyFile))
+	require.NoError(t, err)
+	assert.Equal(t, "page a", string(body))
+}
+
+func TestRunnerMalformedInput(t *testing.T) {
+	srv := zenrowstest.NewServer("test-key")
+	defer srv.Close()
+
+	runner := &jobs.Runner{Client: srv.Client()}
+	err := runner.Run(context.Background(),
+		jobs.NewJobReader(strings.NewReader("not json\n")),
+		jobs.NewResultWriter(io.Discard),
+	)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "line 1")
+}
@@ -0,0 +1,149 @@
+package jobs
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// State is the progress of a job in a Store.
+type State string
+
+// States tracked by a Store.
+const (
+	StatePending  State = "pending"
+	StateInFlight State = "in_flight"
+	StateDone     State = "done"
+	StateFailed   State = "failed"
+)
+
+// entry is a line of the Store log.
+type entry struct {
+	Key   string    `json:"key"`
+	State State     `json:"state"`
+	Time  time.Time `json:"time"`
+	Error string    `json:"error,omitempty"`
+}
+
+// Store persists the state of the jobs of a run in an append-only log file, so a run can be resumed
+// after a crash without scraping the jobs already done again.
+//
+// Every state change is appended as a JSON line. The log survives process crashes, use Sync to also
+// survive system crashes. A partially written last line, e.g. after a crash, is ignored when opening.
+type Store struct {
+	mu     sync.Mutex
+	f      *os.File
+	path   string
+	states map[string]State
+}
+
+// OpenStore opens the store at path, creating it when it doesn't exist, and loads the state of the jobs.
+func OpenStore(path string) (*Store, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open store: %w", err)
+	}
+
+	s := &Store{f: f, path: path, states: map[string]State{}}
+	if err := s.load(); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Store) load() error {
+	reader := bufio.NewReader(s.f)
+	var offset int64
+	for line := 1; ; line++ {
+		data, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// Drop a partially written last line so the next entry starts on its own line.
+			if len(bytes.TrimSpace(data)) > 0 {
+				if err := s.f.Truncate(offset); err != nil {
+					return fmt.Errorf("failed to truncate store: %w", err)
+				}
+			}
+			_, err := s.f.Seek(offset, io.SeekStart)
+			return err
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read store: %w", err)
+		}
+		offset += int64(len(data))
+
+		if len(bytes.TrimSpace(data)) == 0 {
+			continue
+		}
+		var e entry
+		if err := json.Unmarshal(data, &e); err != nil {
+			return fmt.Errorf("failed to decode store %s line %d: %w", s.path, line, err)
+		}
+		s.states[e.Key] = e.State
+	}
+}
+
+// State returns the state of the job with the given key, false when the store doesn't know it.
+func (s *Store) State(key string) (State, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.states[key]
+	return state, ok
+}
+
+// Set records the new state of the job with the given key.
+func (s *Store) Set(key string, state State) error {
+	return s.set(entry{Key: key, State: state})
+}
+
+// SetFailed records the job with the given key as failed, with the reason.
+func (s *Store) SetFailed(key, reason string) error {
+	return s.set(entry{Key: key, State: StateFailed, Error: reason})
+}
+
+func (s *Store) set(e entry) error {
+	e.Time = time.Now().UTC()
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to encode store entry: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write store: %w", err)
+	}
+	s.states[e.Key] = e.State
+	return nil
+}
+
+// Counts returns the number of jobs in each state.
+func (s *Store) Counts() map[State]int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	counts := map[State]int{}
+	for _, state := range s.states {
+		counts[state]++
+	}
+	return counts
+}
+
+// Sync commits the log to stable storage.
+func (s *Store) Sync() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.f.Sync()
+}
+
+// Close closes the log file.
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.f.Close()
+}
@@ -0,0 +1,144 @@
+package jobs_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	"github.com/renatoaraujo/go-zenrows/jobs"
+	"github.com/renatoaraujo/go-zenrows/zenrowstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.log")
+
+	store, err := jobs.OpenStore(path)
+	require.NoError(t, err)
+	require.NoError(t, store.Set("a", jobs.StatePending))
+	require.NoError(t, store.Set("a", jobs.StateInFlight))
+	require.NoError(t, store.Set("a", jobs.StateDone))
+	require.NoError(t, store.Set("b", jobs.StateInFlight))
+	require.NoError(t, store.SetFailed("c", "unexpected status 404"))
+	require.NoError(t, store.Sync())
+	require.NoError(t, store.Close())
+
+	// Simulate a crash in the middle of writing an entry.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"key":"b","sta`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	store, err = jobs.OpenStore(path)
+	require.NoError(t, err)
+	defer store.Close()
+
+	state, ok := store.State("a")
+	assert.True(t, ok)
+	assert.Equal(t, jobs.StateDone, state)
+	state, _ = store.State("b")
+	assert.Equal(t, jobs.StateInFlight, state)
+	_, ok = store.State("unknown")
+	assert.False(t, ok)
+	assert.Equal(t, map[jobs.State]int{jobs.StateDone: 1, jobs.StateInFlight: 1, jobs.StateFailed: 1}, store.Counts())
+
+	require.NoError(t, store.Set("b", jobs.StateDone))
+	require.NoError(t, store.Close())
+
+	store, err = jobs.OpenStore(path)
+	require.NoError(t, err)
+	defer store.Close()
+	state, _ = store.State("b")
+	assert.Equal(t, jobs.StateDone, state)
+}
+
+func TestStoreCorrupted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.log")
+	require.NoError(t, os.WriteFile(path, []byte("not json\n"+`{"key":"a","state":"done"}`+"\n"), 0o644))
+
+	_, err := jobs.OpenStore(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "line 1")
+}
+
+func TestRunnerResume(t *testing.T) {
+	srv := zenrowstest.NewServer("test-key")
+	defer srv.Close()
+	srv.HandleDefault(zenrowstest.Fixture{Body: "ok"})
+	srv.Handle("https://example.com/missing", zenrowstest.Fixture{StatusCode: 404})
+
+	input := `{"id":"a","url":"https://example.com/a"}
+{"id":"b","url":"https://example.com/b"}
+{"id":"c","url":"https://example.com/c"}
+{"url":"https://example.com/missing"}
+`
+	path := filepath.Join(t.TempDir(), "state.log")
+
+	// A previous run finished a, was scraping b when it crashed and never got to c.
+	store, err := jobs.OpenStore(path)
+	require.NoError(t, err)
+	require.NoError(t, store.Set("a", jobs.StateDone))
+	require.NoError(t, store.Set("b", jobs.StateInFlight))
+	require.NoError(t, store.Close())
+
+	store, err = jobs.OpenStore(path)
+	require.NoError(t, err)
+	defer store.Close()
+
+	var output bytes.Buffer
+	runner := &jobs.Runner{Client: srv.Client(), Store: store}
+	require.NoError(t, runner.Run(context.Background(), jobs.NewJobReader(strings.NewReader(input)), jobs.NewResultWriter(&output)))
+
+	results := readResults(t, &output)
+	assert.Len(t, results, 3)
+	assert.NotContains(t, results, "a")
+	assert.Len(t, srv.Requests(), 3)
+	assert.Equal(t, map[jobs.State]int{jobs.StateDone: 3, jobs.StateFailed: 1}, store.Counts())
+
+	// Resuming a complete run doesn't scrape anything, unless failed jobs are retried.
+	output.Reset()
+	require.NoError(t, runner.Run(context.Background(), jobs.NewJobReader(strings.NewReader(input)), jobs.NewResultWriter(&output)))
+	assert.Empty(t, output.String())
+	assert.Len(t, srv.Requests(), 3)
+
+	runner.RetryFailed = true
+	require.NoError(t, runner.Run(context.Background(), jobs.NewJobReader(strings.NewReader(input)), jobs.NewResultWriter(&output)))
+	assert.Len(t, srv.Requests(), 4)
+}
+
+func TestRunnerKeepsCompletedScrapesWhenStopping(t *testing.T) {
+	srv := zenrowstest.NewServer("test-key")
+	defer srv.Close()
+	srv.HandleDefault(zenrowstest.Fixture{Body: "ok"})
+
+	store, err := jobs.OpenStore(filepath.Join(t.TempDir(), "state.log"))
+	require.NoError(t, err)
+	defer store.Close()
+
+	// The run is stopped right after the scrape of a completed, before its result is recorded.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := srv.Client().Use(func(next zenrows.Handler) zenrows.Handler {
+		return func(ctx context.Context, call *zenrows.Call) (*zenrows.Response, error) {
+			resp, err := next(ctx, call)
+			cancel()
+			return resp, err
+		}
+	})
+
+	var output bytes.Buffer
+	runner := &jobs.Runner{Client: client, Store: store, Concurrency: 1}
+	require.NoError(t, runner.Run(ctx, jobs.NewJobReader(strings.NewReader(`{"id":"a","url":"https://example.com/a"}`)), jobs.NewResultWriter(&output)))
+
+	results := readResults(t, &output)
+	assert.Equal(t, "ok", results["a"].Body)
+	state, _ := store.State("a")
+	assert.Equal(t, jobs.StateDone, state)
+}
@@ -0,0 +1,59 @@
+package zenrows
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// JSONResponse is the content returned by ZenRows with WithJSONResponse.
+type JSONResponse struct {
+	// HTML is the rendered page.
+	HTML string `json:"html"`
+	// XHR are the XHR and Fetch requests made by the page.
+	XHR []XHR `json:"xhr"`
+	// JSInstructionsReport describes how the JavaScript instructions went, when there were some.
+	JSInstructionsReport *JSInstructionsReport `json:"js_instructions_report,omitempty"`
+}
+
+// XHR is a XHR or Fetch request made by the page.
+type XHR struct {
+	URL        string            `json:"url"`
+	Method     string            `json:"method,omitempty"`
+	StatusCode int               `json:"status_code,omitempty"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body"`
+}
+
+// JSON decodes the body of the XHR request into v, for requests returning JSON payloads.
+func (x XHR) JSON(v any) error {
+	if err := json.Unmarshal([]byte(x.Body), v); err != nil {
+		return fmt.Errorf("failed to decode xhr body: %w", err)
+	}
+	return nil
+}
+
+// JSInstructionsReport describes how the JavaScript instructions went.
+type JSInstructionsReport struct {
+	Instructions          []InstructionReport `json:"instructions"`
+	InstructionsDuration  int                 `json:"instructions_duration"`
+	InstructionsExecuted  int                 `json:"instructions_executed"`
+	InstructionsSucceeded int                 `json:"instructions_succeeded"`
+	InstructionsFailed    int                 `json:"instructions_failed"`
+}
+
+// InstructionReport describes how a single JavaScript instruction went.
+type InstructionReport struct {
+	Instruction string `json:"instruction"`
+	Params      any    `json:"params,omitempty"`
+	Success     bool   `json:"success"`
+	Duration    int    `json:"duration"`
+}
+
+// JSON decodes the body of a response to a request made WithJSONResponse.
+func (r *Response) JSON() (*JSONResponse, error) {
+	var resp JSONResponse
+	if err := json.Unmarshal([]byte(r.Body), &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode json response: %w", err)
+	}
+	return &resp, nil
+}
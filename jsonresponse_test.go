@@ -0,0 +1,82 @@
+package zenrows_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	mocks "github.com/renatoaraujo/go-zenrows/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const jsonResponseBody = `{
+	"html": "<ul><li>1</li><li>2</li></ul>",
+	"xhr": [
+		{
+			"url": "https://shop.test/api/items?page=2",
+			"method": "GET",
+			"status_code": 200,
+			"headers": {"content-type": "application/json"},
+			"body": "{\"items\":[2]}"
+		}
+	],
+	"js_instructions_report": {
+		"instructions": [
+			{"instruction": "evaluate", "params": {"code": "window.scrollTo(0, document.body.scrollHeight);"}, "success": true, "duration": 12},
+			{"instruction": "wait", "params": {"timeout": 500}, "success": true, "duration": 501}
+		],
+		"instructions_duration": 513,
+		"instructions_executed": 2,
+		"instructions_succeeded": 2,
+		"instructions_failed": 0
+	}
+}`
+
+func TestResponseJSON(t *testing.T) {
+	httpClientMock := mocks.NewHttpClient(t)
+	httpClientMock.On("Do", mock.Anything).Once().Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader([]byte(jsonResponseBody))),
+	}, nil)
+
+	client := zenrows.NewClient(httpClientMock).WithApiKey("key")
+	resp, err := client.Execute(context.Background(), &zenrows.Call{
+		TargetURL: "https://shop.test/",
+		Options:   []zenrows.ScrapeOptions{zenrows.WithScrollCapture(1, 500)},
+	})
+	require.NoError(t, err)
+
+	decoded, err := resp.JSON()
+	require.NoError(t, err)
+	assert.Equal(t, "<ul><li>1</li><li>2</li></ul>", decoded.HTML)
+	require.Len(t, decoded.XHR, 1)
+	assert.Equal(t, "https://shop.test/api/items?page=2", decoded.XHR[0].URL)
+	assert.Equal(t, http.StatusOK, decoded.XHR[0].StatusCode)
+	assert.Equal(t, "application/json", decoded.XHR[0].Headers["content-type"])
+
+	var payload struct {
+		Items []int `json:"items"`
+	}
+	require.NoError(t, decoded.XHR[0].JSON(&payload))
+	assert.Equal(t, []int{2}, payload.Items)
+
+	require.NotNil(t, decoded.JSInstructionsReport)
+	assert.Equal(t, 2, decoded.JSInstructionsReport.InstructionsSucceeded)
+	assert.Equal(t, "wait", decoded.JSInstructionsReport.Instructions[1].Instruction)
+}
+
+func TestResponseJSONInvalid(t *testing.T) {
+	resp := &zenrows.Response{Body: "<html></html>"}
+	_, err := resp.JSON()
+	assert.ErrorContains(t, err, "failed to decode json response")
+
+	_, err = (&zenrows.Response{Body: jsonResponseBody}).JSON()
+	require.NoError(t, err)
+	assert.ErrorContains(t, zenrows.XHR{Body: "nope"}.JSON(&struct{}{}), "failed to decode xhr body")
+}
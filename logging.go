@@ -0,0 +1,90 @@
+package zenrows
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+)
+
+// WithLogger Enables structured logging of every scrape with the given logger.
+//
+// Requests are logged at debug level when they start and at info level when they finish successfully,
+// responses with a non 2xx status are logged at warn level and failed requests at error level.
+// The apikey is never logged.
+func (c *Client) WithLogger(logger *slog.Logger) *Client {
+	c.logger = logger
+	return c
+}
+
+// WithLogSampling Only logs 1 out of every n successful scrapes to keep high volume clients quiet.
+// Warnings and errors are always logged. A value lower or equal to 1 logs every scrape.
+func (c *Client) WithLogSampling(n int) *Client {
+	c.logSampling = uint64(max(n, 1))
+	return c
+}
+
+// sampleLog decides whether the debug and info logs of the next scrape should be emitted.
+func (c *Client) sampleLog() bool {
+	if c.logger == nil {
+		return false
+	}
+	if c.logSampling <= 1 {
+		return true
+	}
+	return (c.logCounter.Add(1)-1)%c.logSampling == 0
+}
+
+func (c *Client) logScrapeStarted(ctx context.Context, info ScrapeInfo, sampled bool) {
+	if c.logger == nil || !sampled {
+		return
+	}
+	c.logger.LogAttrs(ctx, slog.LevelDebug, "zenrows scrape started",
+		slog.String("host", info.Host),
+		slog.String("options", info.Options.String()),
+	)
+}
+
+func (c *Client) logScrapeFinished(ctx context.Context, info ScrapeInfo, result ScrapeResult, sampled bool) {
+	if c.logger == nil {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("host", info.Host),
+		slog.String("options", info.Options.String()),
+		slog.Duration("duration", result.Duration),
+	}
+
+	if result.Err != nil {
+		attrs = append(attrs,
+			slog.String("error", result.Err.Error()),
+			slog.String("error_class", string(result.ErrorClass)),
+		)
+		c.logger.LogAttrs(ctx, slog.LevelError, "zenrows scrape failed", attrs...)
+		return
+	}
+
+	attrs = append(attrs,
+		slog.Int("status", result.StatusCode),
+		slog.Int("bytes", result.BodySize),
+	)
+	if result.Cost >= 0 {
+		attrs = append(attrs, slog.Float64("cost", result.Cost))
+	}
+
+	if result.ErrorClass != ErrorClassNone {
+		c.logger.LogAttrs(ctx, slog.LevelWarn, "zenrows scrape finished with unexpected status", attrs...)
+		return
+	}
+	if sampled {
+		c.logger.LogAttrs(ctx, slog.LevelInfo, "zenrows scrape finished", attrs...)
+	}
+}
+
+func targetHost(targetURL string) string {
+	u, err := url.Parse(targetURL)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
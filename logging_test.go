@@ -0,0 +1,129 @@
+package zenrows_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	mocks "github.com/renatoaraujo/go-zenrows/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeLogs(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var record map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestScrapeLogging(t *testing.T) {
+	tests := []struct {
+		name            string
+		httpClientSetup func(client *mocks.HttpClient)
+		expectedLevels  []string
+	}{
+		{
+			name: "Successful scrape",
+			httpClientSetup: func(s *mocks.HttpClient) {
+				s.On("Do", mock.Anything).
+					Once().
+					Return(&http.Response{
+						StatusCode: 200,
+						Header:     http.Header{"X-Request-Cost": []string{"5"}},
+						Body:       io.NopCloser(bytes.NewReader([]byte("some content"))),
+					}, nil)
+			},
+			expectedLevels: []string{"DEBUG", "INFO"},
+		},
+		{
+			name: "Unexpected status",
+			httpClientSetup: func(s *mocks.HttpClient) {
+				s.On("Do", mock.Anything).
+					Once().
+					Return(&http.Response{
+						StatusCode: 429,
+						Body:       io.NopCloser(bytes.NewReader([]byte("too many requests"))),
+					}, nil)
+			},
+			expectedLevels: []string{"DEBUG", "WARN"},
+		},
+		{
+			name: "Failed request",
+			httpClientSetup: func(s *mocks.HttpClient) {
+				s.On("Do", mock.Anything).
+					Once().
+					Return(nil, errors.New("failed to make the request"))
+			},
+			expectedLevels: []string{"DEBUG", "ERROR"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClientMock := mocks.NewHttpClient(t)
+			tt.httpClientSetup(httpClientMock)
+
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+			client := zenrows.NewClient(httpClientMock).
+				WithApiKey("secret-key").
+				WithLogger(logger)
+
+			_, _ = client.Scrape(context.Background(), "https://example.com/page", zenrows.WithDeviceType(zenrows.DeviceMobile))
+
+			assert.NotContains(t, buf.String(), "secret-key")
+
+			records := decodeLogs(t, &buf)
+			var levels []string
+			for _, record := range records {
+				levels = append(levels, record["level"].(string))
+				assert.Equal(t, "example.com", record["host"])
+				assert.Equal(t, "device=mobile", record["options"])
+			}
+			assert.Equal(t, tt.expectedLevels, levels)
+		})
+	}
+}
+
+func TestScrapeLogSampling(t *testing.T) {
+	httpClientMock := mocks.NewHttpClient(t)
+	httpClientMock.On("Do", mock.Anything).
+		Times(4).
+		Return(func(*http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       io.NopCloser(bytes.NewReader([]byte("some content"))),
+			}, nil
+		})
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	client := zenrows.NewClient(httpClientMock).
+		WithApiKey("key").
+		WithLogger(logger).
+		WithLogSampling(2)
+
+	for i := 0; i < 4; i++ {
+		_, err := client.Scrape(context.Background(), "https://example.com")
+		require.NoError(t, err)
+	}
+
+	assert.Len(t, decodeLogs(t, &buf), 2)
+}
@@ -0,0 +1,76 @@
+package zenrows
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Call is a logical scrape going through the middleware chain of a Client.
+type Call struct {
+	// TargetURL is the URL of the website to scrape.
+	TargetURL string
+	// Options are the per-call options, the client default options are applied before them.
+	Options []ScrapeOptions
+	// Header contains additional headers sent to the ZenRows API.
+	Header http.Header
+	// APIKey overrides the apikey configured on the client for this call when not empty.
+	APIKey string
+}
+
+// Handler executes a Call.
+type Handler func(ctx context.Context, call *Call) (*Response, error)
+
+// Middleware wraps a Handler with cross-cutting behaviour such as caching, key rotation or fault injection.
+// It can inspect and modify the Call before calling next, inspect the Response after it, or not call next at all.
+//
+// Example usage:
+//
+//	client.Use(func(next zenrows.Handler) zenrows.Handler {
+//	    return func(ctx context.Context, call *zenrows.Call) (*zenrows.Response, error) {
+//	        resp, err := next(ctx, call)
+//	        log.Printf("scraped %s", call.TargetURL)
+//	        return resp, err
+//	    }
+//	})
+type Middleware func(next Handler) Handler
+
+// Use Adds middlewares around every scrape made by the client, the first one being the outermost
+func (c *Client) Use(middlewares ...Middleware) *Client {
+	c.middlewares = append(c.middlewares, middlewares...)
+	return c
+}
+
+// Execute runs the given Call through the client middlewares and returns the full ZenRows response.
+//
+// Unlike Scrape, it gives access to the response status and headers, e.g. the request cost.
+// Observers and the logger see every call, including the ones a middleware answers without reaching ZenRows,
+// and the apikey is redacted from the errors returned by the middlewares too.
+func (c *Client) Execute(ctx context.Context, call *Call) (*Response, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	start := time.Now()
+	sampled := c.sampleLog()
+	info := c.scrapeInfo(call.TargetURL, call.Options)
+	ctx = c.notifyScrapeStarted(ctx, info)
+	c.logScrapeStarted(ctx, info, sampled)
+
+	key := c.apiKey(call)
+	handler := c.send
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		handler = c.middlewares[i](handler)
+	}
+	resp, err := handler(ctx, call)
+
+	// A middleware may have rotated the apikey of the call, so both keys are redacted.
+	err = c.redactError(err, key, c.apiKey(call))
+	c.scrapeFinished(ctx, info, newScrapeResult(resp, err, time.Since(start)), sampled)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
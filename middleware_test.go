@@ -0,0 +1,122 @@
+package zenrows_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	mocks "github.com/renatoaraujo/go-zenrows/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddlewares(t *testing.T) {
+	var order []string
+	tracing := func(name string) zenrows.Middleware {
+		return func(next zenrows.Handler) zenrows.Handler {
+			return func(ctx context.Context, call *zenrows.Call) (*zenrows.Response, error) {
+				order = append(order, name+" before")
+				resp, err := next(ctx, call)
+				order = append(order, name+" after")
+				return resp, err
+			}
+		}
+	}
+	rotateKey := func(next zenrows.Handler) zenrows.Handler {
+		return func(ctx context.Context, call *zenrows.Call) (*zenrows.Response, error) {
+			call.APIKey = "rotated-key"
+			call.Options = append(call.Options, zenrows.WithDeviceType(zenrows.DeviceMobile))
+			return next(ctx, call)
+		}
+	}
+
+	httpClientMock := mocks.NewHttpClient(t)
+	httpClientMock.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		query := req.URL.Query()
+		return query.Get("apikey") == "rotated-key" && query.Get("device") == "mobile" && req.Header.Get("X-Trace") == "1"
+	})).
+		Once().
+		Return(&http.Response{
+			StatusCode: 200,
+			Header:     http.Header{"X-Request-Cost": []string{"1"}},
+			Body:       io.NopCloser(bytes.NewReader([]byte("some content"))),
+		}, nil)
+
+	client := zenrows.NewClient(httpClientMock).
+		WithApiKey("key").
+		Use(tracing("first"), tracing("second"), rotateKey)
+
+	resp, err := client.Execute(context.Background(), &zenrows.Call{
+		TargetURL: "https://example.com",
+		Header:    http.Header{"X-Trace": []string{"1"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "some content", resp.Body)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, float64(1), resp.Cost())
+	assert.Equal(t, []string{"first before", "second before", "second after", "first after"}, order)
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	cache := map[string]*zenrows.Response{
+		"https://example.com": {StatusCode: 200, Body: "cached content"},
+	}
+	caching := func(next zenrows.Handler) zenrows.Handler {
+		return func(ctx context.Context, call *zenrows.Call) (*zenrows.Response, error) {
+			if resp, ok := cache[call.TargetURL]; ok {
+				return resp, nil
+			}
+			return next(ctx, call)
+		}
+	}
+
+	observer := &recordingObserver{}
+	client := zenrows.NewClient(mocks.NewHttpClient(t)).
+		WithApiKey("key").
+		WithObserver(observer).
+		Use(caching)
+
+	content, err := client.Scrape(context.Background(), "https://example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "cached content", content)
+
+	require.Len(t, observer.infos, 1)
+	require.Len(t, observer.results, 1)
+	assert.Equal(t, 200, observer.results[0].StatusCode)
+	assert.Equal(t, zenrows.ErrorClassNone, observer.results[0].ErrorClass)
+}
+
+func TestMiddlewareErrorIsRedacted(t *testing.T) {
+	rejecting := func(next zenrows.Handler) zenrows.Handler {
+		return func(ctx context.Context, call *zenrows.Call) (*zenrows.Response, error) {
+			return nil, fmt.Errorf("rate limit exceeded for https://api.zenrows.com/v1/?apikey=%s", call.APIKey)
+		}
+	}
+	rotateKey := func(next zenrows.Handler) zenrows.Handler {
+		return func(ctx context.Context, call *zenrows.Call) (*zenrows.Response, error) {
+			call.APIKey = "rotated-key"
+			return next(ctx, call)
+		}
+	}
+
+	observer := &recordingObserver{}
+	client := zenrows.NewClient(mocks.NewHttpClient(t)).
+		WithApiKey("secret-key").
+		WithObserver(observer).
+		Use(rotateKey, rejecting)
+
+	_, err := client.Scrape(context.Background(), "https://example.com")
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "rotated-key")
+	assert.Contains(t, err.Error(), "apikey=REDACTED")
+
+	require.Len(t, observer.results, 1)
+	assert.Equal(t, err, observer.results[0].Err)
+	assert.Equal(t, zenrows.ErrorClassTransport, observer.results[0].ErrorClass)
+}
@@ -0,0 +1,175 @@
+package zenrows
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// ErrorClass classifies the outcome of a scrape, e.g. to label metrics.
+type ErrorClass string
+
+// Error classes reported in ScrapeResult.
+const (
+	// ErrorClassNone means the scrape succeeded with a 2xx status.
+	ErrorClassNone ErrorClass = ""
+	// ErrorClassValidation means the target url or the options were rejected before sending the request.
+	ErrorClassValidation ErrorClass = "validation"
+	// ErrorClassCanceled means the context was canceled.
+	ErrorClassCanceled ErrorClass = "canceled"
+	// ErrorClassTimeout means the context deadline was exceeded.
+	ErrorClassTimeout ErrorClass = "timeout"
+	// ErrorClassTransport means the request could not be sent or the response could not be read.
+	ErrorClassTransport ErrorClass = "transport"
+	// ErrorClassClientStatus means ZenRows answered with a 4xx status.
+	ErrorClassClientStatus ErrorClass = "client_status"
+	// ErrorClassServerStatus means ZenRows answered with a 5xx status.
+	ErrorClassServerStatus ErrorClass = "server_status"
+)
+
+// ScrapeInfo describes a scrape about to be made.
+type ScrapeInfo struct {
+	// TargetURL is the URL of the website being scraped.
+	TargetURL string
+	// Host is the host of TargetURL.
+	Host string
+	// Options are the options of the scrape, including the client default options.
+	Options ScrapeRequest
+}
+
+// ScrapeResult describes the outcome of a scrape.
+type ScrapeResult struct {
+	// StatusCode is the status returned by ZenRows, zero when no response was received.
+	StatusCode int
+	// Header contains the headers returned by ZenRows, nil when no response was received.
+	Header http.Header
+	// BodySize is the size of the returned content in bytes.
+	BodySize int
+	// Cost is the amount of credits ZenRows reported for the request, -1 when it's unknown.
+	Cost float64
+	// ConcurrencyLimit is the concurrency of the ZenRows plan reported by ZenRows, -1 when it's unknown.
+	ConcurrencyLimit float64
+	// ConcurrencyRemaining is the number of concurrent requests left reported by ZenRows, -1 when it's unknown.
+	ConcurrencyRemaining float64
+	// Duration is the time spent on the scrape.
+	Duration time.Duration
+	// Err is the error returned by Scrape, with the apikey redacted.
+	Err error
+	// ErrorClass classifies Err, or the status code when the scrape didn't fail.
+	ErrorClass ErrorClass
+}
+
+// Observer is notified about the lifecycle of every scrape made by a Client, e.g. to record traces or metrics.
+// Observers must be safe for concurrent use.
+type Observer interface {
+	// ScrapeStarted is called before the scrape is made. The returned context is used for the request
+	// and passed to ScrapeFinished, so it can carry a tracing span.
+	ScrapeStarted(ctx context.Context, info ScrapeInfo) context.Context
+	// ScrapeFinished is called once the scrape is done, whether it failed or not.
+	ScrapeFinished(ctx context.Context, info ScrapeInfo, result ScrapeResult)
+}
+
+// WithObserver Registers observers notified about every scrape made by the client
+func (c *Client) WithObserver(observers ...Observer) *Client {
+	c.observers = append(c.observers, observers...)
+	return c
+}
+
+func (c *Client) scrapeInfo(targetURL string, params []ScrapeOptions) ScrapeInfo {
+	options, _ := NewScrapeRequest(append(append([]ScrapeOptions{}, c.config.DefaultOptions...), params...)...)
+	return ScrapeInfo{
+		TargetURL: targetURL,
+		Host:      targetHost(targetURL),
+		Options:   options,
+	}
+}
+
+func (c *Client) notifyScrapeStarted(ctx context.Context, info ScrapeInfo) context.Context {
+	for _, o := range c.observers {
+		ctx = o.ScrapeStarted(ctx, info)
+	}
+	return ctx
+}
+
+func (c *Client) notifyScrapeFinished(ctx context.Context, info ScrapeInfo, result ScrapeResult) {
+	for i := len(c.observers) - 1; i >= 0; i-- {
+		c.observers[i].ScrapeFinished(ctx, info, result)
+	}
+}
+
+func newScrapeResult(resp *Response, err error, duration time.Duration) ScrapeResult {
+	result := ScrapeResult{
+		Cost:                 -1,
+		ConcurrencyLimit:     -1,
+		ConcurrencyRemaining: -1,
+		Duration:             duration,
+		Err:                  err,
+		ErrorClass:           classifyError(err),
+	}
+	if resp != nil {
+		result.StatusCode = resp.StatusCode
+		result.Header = resp.Header
+		result.BodySize = len(resp.Body)
+		result.Cost = resp.Cost()
+		result.ConcurrencyLimit = resp.ConcurrencyLimit()
+		result.ConcurrencyRemaining = resp.ConcurrencyRemaining()
+		if result.ErrorClass == ErrorClassNone {
+			result.ErrorClass = classifyStatus(resp.StatusCode)
+		}
+	}
+	return result
+}
+
+// validationError marks the errors of a call rejected before sending the request, see ErrorClassValidation.
+type validationError struct {
+	err error
+}
+
+func (e *validationError) Error() string {
+	return e.err.Error()
+}
+
+func (e *validationError) Unwrap() error {
+	return e.err
+}
+
+// transportError marks the errors of a request that could not be sent or whose response could not be read.
+type transportError struct {
+	err error
+}
+
+func (e *transportError) Error() string {
+	return e.err.Error()
+}
+
+func (e *transportError) Unwrap() error {
+	return e.err
+}
+
+func classifyError(err error) ErrorClass {
+	var validationErr *validationError
+	switch {
+	case err == nil:
+		return ErrorClassNone
+	case errors.As(err, &validationErr):
+		return ErrorClassValidation
+	case errors.Is(err, context.Canceled):
+		return ErrorClassCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrorClassTimeout
+	default:
+		return ErrorClassTransport
+	}
+}
+
+func classifyStatus(status int) ErrorClass {
+	switch {
+	case status >= 500:
+		return ErrorClassServerStatus
+	case status >= 400:
+		return ErrorClassClientStatus
+	default:
+		return ErrorClassNone
+	}
+}
@@ -0,0 +1,115 @@
+package zenrows_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	mocks "github.com/renatoaraujo/go-zenrows/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type ctxKey struct{}
+
+type recordingObserver struct {
+	mu      sync.Mutex
+	infos   []zenrows.ScrapeInfo
+	results []zenrows.ScrapeResult
+	ctxOK   []bool
+}
+
+func (o *recordingObserver) ScrapeStarted(ctx context.Context, info zenrows.ScrapeInfo) context.Context {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.infos = append(o.infos, info)
+	return context.WithValue(ctx, ctxKey{}, true)
+}
+
+func (o *recordingObserver) ScrapeFinished(ctx context.Context, _ zenrows.ScrapeInfo, result zenrows.ScrapeResult) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.results = append(o.results, result)
+	o.ctxOK = append(o.ctxOK, ctx.Value(ctxKey{}) == true)
+}
+
+func TestScrapeObserver(t *testing.T) {
+	tests := []struct {
+		name            string
+		options         []zenrows.ScrapeOptions
+		httpClientSetup func(client *mocks.HttpClient)
+		expectedStatus  int
+		expectedCost    float64
+		expectedClass   zenrows.ErrorClass
+	}{
+		{
+			name: "Successful scrape",
+			httpClientSetup: func(s *mocks.HttpClient) {
+				s.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+					return req.Context().Value(ctxKey{}) == true
+				})).
+					Once().
+					Return(&http.Response{
+						StatusCode: 200,
+						Header:     http.Header{"X-Request-Cost": []string{"10"}},
+						Body:       io.NopCloser(bytes.NewReader([]byte("some content"))),
+					}, nil)
+			},
+			expectedStatus: 200,
+			expectedCost:   10,
+			expectedClass:  zenrows.ErrorClassNone,
+		},
+		{
+			name: "Server error status",
+			httpClientSetup: func(s *mocks.HttpClient) {
+				s.On("Do", mock.Anything).
+					Once().
+					Return(&http.Response{
+						StatusCode: 503,
+						Body:       io.NopCloser(bytes.NewReader([]byte("unavailable"))),
+					}, nil)
+			},
+			expectedStatus: 503,
+			expectedCost:   -1,
+			expectedClass:  zenrows.ErrorClassServerStatus,
+		},
+		{
+			name:          "Invalid options",
+			options:       []zenrows.ScrapeOptions{zenrows.WithDevice("tablet")},
+			expectedCost:  -1,
+			expectedClass: zenrows.ErrorClassValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClientMock := mocks.NewHttpClient(t)
+			if tt.httpClientSetup != nil {
+				tt.httpClientSetup(httpClientMock)
+			}
+
+			observer := &recordingObserver{}
+			client := zenrows.NewClient(httpClientMock, zenrows.WithJSRender()).
+				WithApiKey("key").
+				WithObserver(observer)
+			_, _ = client.Scrape(context.Background(), "https://example.com/page", tt.options...)
+
+			require.Len(t, observer.infos, 1)
+			assert.Equal(t, "example.com", observer.infos[0].Host)
+			assert.Equal(t, zenrows.Bool(true), observer.infos[0].Options.JSRender)
+
+			require.Len(t, observer.results, 1)
+			result := observer.results[0]
+			assert.Equal(t, tt.expectedStatus, result.StatusCode)
+			assert.Equal(t, tt.expectedCost, result.Cost)
+			assert.Equal(t, tt.expectedClass, result.ErrorClass)
+			assert.Equal(t, []bool{true}, observer.ctxOK)
+		})
+	}
+}
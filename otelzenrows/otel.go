@@ -0,0 +1,159 @@
+// Package otelzenrows provides OpenTelemetry instrumentation for the go-zenrows client.
+//
+// It records a span per scrape and metrics for latency, errors, credits spent and in-flight requests.
+// Register it on a client with:
+//
+//	observer, err := otelzenrows.NewObserver()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	client := zenrows.NewClient(hc).WithApiKey("ZENROWS_API_KEY").WithObserver(observer)
+package otelzenrows
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/renatoaraujo/go-zenrows"
+)
+
+const instrumentationName = "github.com/renatoaraujo/go-zenrows/otelzenrows"
+
+// Attribute keys recorded on spans and metrics.
+const (
+	AttributeTargetHost = attribute.Key("zenrows.target.host")
+	AttributeOptions    = attribute.Key("zenrows.options")
+	AttributeStatusCode = attribute.Key("http.response.status_code")
+	AttributeCost       = attribute.Key("zenrows.cost")
+	AttributeErrorClass = attribute.Key("zenrows.error.class")
+)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// Option configures the Observer.
+type Option func(*config)
+
+// WithTracerProvider sets the TracerProvider used to create spans, the global one is used by default.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the MeterProvider used to create metrics, the global one is used by default.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+// Observer is a zenrows.Observer recording OpenTelemetry traces and metrics.
+type Observer struct {
+	tracer trace.Tracer
+
+	duration metric.Float64Histogram
+	errors   metric.Int64Counter
+	credits  metric.Float64Counter
+	inFlight metric.Int64UpDownCounter
+}
+
+var _ zenrows.Observer = (*Observer)(nil)
+
+// NewObserver creates an Observer with the given options.
+func NewObserver(opts ...Option) (*Observer, error) {
+	cfg := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	meter := cfg.meterProvider.Meter(instrumentationName)
+	o := &Observer{
+		tracer: cfg.tracerProvider.Tracer(instrumentationName),
+	}
+
+	var err error
+	if o.duration, err = meter.Float64Histogram("zenrows.scrape.duration",
+		metric.WithDescription("Duration of ZenRows scrapes."),
+		metric.WithUnit("s"),
+	); err != nil {
+		return nil, fmt.Errorf("failed to create duration histogram: %w", err)
+	}
+	if o.errors, err = meter.Int64Counter("zenrows.scrape.errors",
+		metric.WithDescription("Number of failed ZenRows scrapes by error class."),
+		metric.WithUnit("{scrape}"),
+	); err != nil {
+		return nil, fmt.Errorf("failed to create errors counter: %w", err)
+	}
+	if o.credits, err = meter.Float64Counter("zenrows.credits",
+		metric.WithDescription("ZenRows credits spent."),
+		metric.WithUnit("{credit}"),
+	); err != nil {
+		return nil, fmt.Errorf("failed to create credits counter: %w", err)
+	}
+	if o.inFlight, err = meter.Int64UpDownCounter("zenrows.scrape.in_flight",
+		metric.WithDescription("Number of ZenRows scrapes in flight."),
+		metric.WithUnit("{scrape}"),
+	); err != nil {
+		return nil, fmt.Errorf("failed to create in-flight counter: %w", err)
+	}
+
+	return o, nil
+}
+
+// ScrapeStarted starts the scrape span and counts the scrape as in flight.
+func (o *Observer) ScrapeStarted(ctx context.Context, info zenrows.ScrapeInfo) context.Context {
+	ctx, _ = o.tracer.Start(ctx, "zenrows.Scrape",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			AttributeTargetHost.String(info.Host),
+			AttributeOptions.String(info.Options.String()),
+		),
+	)
+	o.inFlight.Add(ctx, 1, metric.WithAttributes(AttributeTargetHost.String(info.Host)))
+	return ctx
+}
+
+// ScrapeFinished ends the scrape span and records the scrape metrics.
+func (o *Observer) ScrapeFinished(ctx context.Context, info zenrows.ScrapeInfo, result zenrows.ScrapeResult) {
+	host := AttributeTargetHost.String(info.Host)
+	o.inFlight.Add(ctx, -1, metric.WithAttributes(host))
+
+	attrs := []attribute.KeyValue{host}
+	if result.StatusCode != 0 {
+		attrs = append(attrs, AttributeStatusCode.Int(result.StatusCode))
+	}
+	if result.ErrorClass != zenrows.ErrorClassNone {
+		attrs = append(attrs, AttributeErrorClass.String(string(result.ErrorClass)))
+		o.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+	o.duration.Record(ctx, result.Duration.Seconds(), metric.WithAttributes(attrs...))
+	if result.Cost >= 0 {
+		o.credits.Add(ctx, result.Cost, metric.WithAttributes(host))
+	}
+
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attrs...)
+	if result.Cost >= 0 {
+		span.SetAttributes(AttributeCost.Float64(result.Cost))
+	}
+	switch {
+	case result.Err != nil:
+		span.RecordError(result.Err)
+		span.SetStatus(codes.Error, result.Err.Error())
+	case result.ErrorClass != zenrows.ErrorClassNone:
+		span.SetStatus(codes.Error, fmt.Sprintf("unexpected status %d", result.StatusCode))
+	}
+	span.End()
+}
@@ -0,0 +1,154 @@
+package otelzenrows_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	mocks "github.com/renatoaraujo/go-zenrows/mocks"
+	"github.com/renatoaraujo/go-zenrows/otelzenrows"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestObserver(t *testing.T) {
+	tests := []struct {
+		name               string
+		httpClientSetup    func(client *mocks.HttpClient)
+		expectedStatus     codes.Code
+		expectedErrorClass string
+		expectedCredits    float64
+	}{
+		{
+			name: "Successful scrape",
+			httpClientSetup: func(s *mocks.HttpClient) {
+				s.On("Do", mock.Anything).
+					Once().
+					Return(&http.Response{
+						StatusCode: 200,
+						Header:     http.Header{"X-Request-Cost": []string{"5"}},
+						Body:       io.NopCloser(bytes.NewReader([]byte("some content"))),
+					}, nil)
+			},
+			expectedStatus:  codes.Unset,
+			expectedCredits: 5,
+		},
+		{
+			name: "Unexpected status",
+			httpClientSetup: func(s *mocks.HttpClient) {
+				s.On("Do", mock.Anything).
+					Once().
+					Return(&http.Response{
+						StatusCode: 402,
+						Body:       io.NopCloser(bytes.NewReader([]byte("payment required"))),
+					}, nil)
+			},
+			expectedStatus:     codes.Error,
+			expectedErrorClass: "client_status",
+		},
+		{
+			name: "Failed request",
+			httpClientSetup: func(s *mocks.HttpClient) {
+				s.On("Do", mock.Anything).
+					Once().
+					Return(nil, errors.New("connection refused"))
+			},
+			expectedStatus:     codes.Error,
+			expectedErrorClass: "transport",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spans := tracetest.NewSpanRecorder()
+			reader := sdkmetric.NewManualReader()
+
+			observer, err := otelzenrows.NewObserver(
+				otelzenrows.WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+				otelzenrows.WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+			)
+			require.NoError(t, err)
+
+			httpClientMock := mocks.NewHttpClient(t)
+			tt.httpClientSetup(httpClientMock)
+
+			client := zenrows.NewClient(httpClientMock).
+				WithApiKey("key").
+				WithObserver(observer)
+			_, _ = client.Scrape(context.Background(), "https://example.com/page", zenrows.WithJSRender())
+
+			ended := spans.Ended()
+			require.Len(t, ended, 1)
+			span := ended[0]
+			assert.Equal(t, "zenrows.Scrape", span.Name())
+			assert.Equal(t, tt.expectedStatus, span.Status().Code)
+			assert.Contains(t, span.Attributes(), otelzenrows.AttributeTargetHost.String("example.com"))
+			assert.Contains(t, span.Attributes(), otelzenrows.AttributeOptions.String("js_render=true"))
+
+			var rm metricdata.ResourceMetrics
+			require.NoError(t, reader.Collect(context.Background(), &rm))
+			metrics := map[string]metricdata.Metrics{}
+			for _, sm := range rm.ScopeMetrics {
+				for _, m := range sm.Metrics {
+					metrics[m.Name] = m
+				}
+			}
+
+			inFlight := metrics["zenrows.scrape.in_flight"].Data.(metricdata.Sum[int64])
+			require.Len(t, inFlight.DataPoints, 1)
+			assert.Equal(t, int64(0), inFlight.DataPoints[0].Value)
+
+			duration := metrics["zenrows.scrape.duration"].Data.(metricdata.Histogram[float64])
+			require.Len(t, duration.DataPoints, 1)
+			assert.Equal(t, uint64(1), duration.DataPoints[0].Count)
+
+			if tt.expectedErrorClass != "" {
+				errorsCount := metrics["zenrows.scrape.errors"].Data.(metricdata.Sum[int64])
+				require.Len(t, errorsCount.DataPoints, 1)
+				class, ok := errorsCount.DataPoints[0].Attributes.Value(otelzenrows.AttributeErrorClass)
+				require.True(t, ok)
+				assert.Equal(t, attribute.StringValue(tt.expectedErrorClass), class)
+			} else {
+				assert.NotContains(t, metrics, "zenrows.scrape.errors")
+			}
+
+			if tt.expectedCredits > 0 {
+				credits := metrics["zenrows.credits"].Data.(metricdata.Sum[float64])
+				require.Len(t, credits.DataPoints, 1)
+				assert.Equal(t, tt.expectedCredits, credits.DataPoints[0].Value)
+			}
+		})
+	}
+}
+
+func TestObserverValidationError(t *testing.T) {
+	spans := tracetest.NewSpanRecorder()
+	observer, err := otelzenrows.NewObserver(
+		otelzenrows.WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		otelzenrows.WithMeterProvider(sdkmetric.NewMeterProvider()),
+	)
+	require.NoError(t, err)
+
+	client := zenrows.NewClient(mocks.NewHttpClient(t)).
+		WithApiKey("key").
+		WithObserver(observer)
+	_, err = client.Scrape(context.Background(), "https://example.com", zenrows.WithWait(-1))
+	require.Error(t, err)
+
+	ended := spans.Ended()
+	require.Len(t, ended, 1)
+	assert.Equal(t, codes.Error, ended[0].Status().Code)
+	assert.Contains(t, ended[0].Attributes(), otelzenrows.AttributeErrorClass.String("validation"))
+}
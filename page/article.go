@@ -0,0 +1,260 @@
+package page
+
+import (
+	"strings"
+	"time"
+
+	"github.com/andybalholm/cascadia"
+	"golang.org/x/net/html"
+)
+
+var (
+	headingSelector = cascadia.MustCompile("h1")
+	bylineSelector  = cascadia.MustCompile(`[rel~=author], [itemprop~=author], .byline, .author, [class*=byline], [class*=author]`)
+	timeSelector    = cascadia.MustCompile("time[datetime]")
+	imageSelector   = cascadia.MustCompile("img[src]")
+	langSelector    = cascadia.MustCompile("html[lang]")
+	canonical       = cascadia.MustCompile(`link[rel~=canonical][href]`)
+	paragraph       = cascadia.MustCompile("p")
+)
+
+// titleSeparators split the site name from the title of a page, e.g. "Choosing shoes | Shop".
+var titleSeparators = []string{" | ", " - ", " – ", " — ", " :: ", " » ", " / "}
+
+// dateLayouts are the layouts published dates are parsed with. Fractional seconds are accepted after the seconds
+// of any layout.
+var dateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05Z0700",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	time.RFC1123Z,
+	time.RFC1123,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"January 2, 2006",
+	"Jan 2, 2006",
+	"2 January 2006",
+}
+
+// Article is the main content of a page with its metadata, as extracted by Document.Article.
+type Article struct {
+	// Title is the title of the article, without the name of the site.
+	Title string `json:"title"`
+	// Byline are the authors of the article.
+	Byline string `json:"byline,omitempty"`
+	// Published is when the article was published, nil when unknown.
+	Published *time.Time `json:"published,omitempty"`
+	// LeadImage is the absolute URL of the main image of the article.
+	LeadImage string `json:"lead_image,omitempty"`
+	// SiteName is the name of the website.
+	SiteName string `json:"site_name,omitempty"`
+	// Excerpt is a short description of the article.
+	Excerpt string `json:"excerpt,omitempty"`
+	// Lang is the language of the page.
+	Lang string `json:"lang,omitempty"`
+	// URL is the canonical URL of the article.
+	URL string `json:"url,omitempty"`
+	// Content is the cleaned up HTML of the article body.
+	Content string `json:"content"`
+	// Text is the article body as plain text.
+	Text string `json:"text"`
+	// Markdown is the article body as Markdown.
+	Markdown string `json:"markdown"`
+}
+
+// Article extracts the main content of the page, e.g. the body of a news article, along with its title, byline,
+// published date and lead image. The content is found by scoring the elements of the page on the amount of text
+// they hold, the metadata is read from the structured data of the page first, then from its markup.
+//
+// It returns ErrNoArticle when the page has no paragraph of text.
+func (d *Document) Article() (*Article, error) {
+	nodes := (&readability{doc: d}).content()
+	if len(nodes) == 0 {
+		return nil, ErrNoArticle
+	}
+
+	data := d.StructuredData()
+	meta := &SchemaArticle{}
+	if articles := As[SchemaArticle](data); len(articles) > 0 {
+		meta = &articles[0]
+	}
+
+	article := &Article{SiteName: data.OpenGraph.SiteName}
+	var content, text, markdown []string
+	for _, node := range nodes {
+		el := Element{Node: node, doc: d}
+		content = append(content, el.HTML())
+		text = append(text, el.PlainText())
+		markdown = append(markdown, el.Markdown())
+	}
+	article.Content = strings.Join(content, "\n")
+	article.Text = strings.Join(text, "\n\n")
+	article.Markdown = strings.Join(markdown, "\n\n")
+
+	article.Title = d.articleTitle(meta, data)
+	article.Byline = d.byline(meta)
+	article.Published = d.published(meta)
+	article.LeadImage = d.leadImage(meta, data, nodes)
+	article.Excerpt = firstNonEmpty(meta.Description, data.OpenGraph.Description, d.metaContent("description"))
+	if article.Excerpt == "" {
+		article.Excerpt = firstParagraph(nodes)
+	}
+	if lang, ok := d.firstAttr(langSelector, "lang"); ok {
+		article.Lang = strings.TrimSpace(lang)
+	}
+	article.URL = firstNonEmpty(data.OpenGraph.URL, d.absAttr(canonical, "href"), d.base.String())
+	return article, nil
+}
+
+func (d *Document) articleTitle(meta *SchemaArticle, data *StructuredData) string {
+	if title := firstNonEmpty(meta.Headline, meta.Name, data.OpenGraph.Title, data.TwitterCard.Title); title != "" {
+		return title
+	}
+
+	headings := d.findAll(headingSelector)
+	title := d.Title()
+	if len(headings) == 1 && (title == "" || strings.Contains(title, headings[0].Text())) {
+		return headings[0].Text()
+	}
+	for _, separator := range titleSeparators {
+		if i := strings.LastIndex(title, separator); i > 0 {
+			if head := title[:i]; len(strings.Fields(head)) >= 3 || strings.EqualFold(strings.TrimSpace(title[i+len(separator):]), data.OpenGraph.SiteName) {
+				return strings.TrimSpace(head)
+			}
+		}
+	}
+	return title
+}
+
+func (d *Document) byline(meta *SchemaArticle) string {
+	var authors []string
+	for _, author := range meta.Authors {
+		if author.Name != "" {
+			authors = append(authors, author.Name)
+		}
+	}
+	if len(authors) == 0 {
+		if author := d.metaContent("author"); author != "" {
+			authors = []string{author}
+		}
+	}
+	if len(authors) == 0 {
+		for _, el := range d.findAll(bylineSelector) {
+			text := el.Text()
+			if content, ok := el.Attr("content"); ok && text == "" {
+				text = content
+			}
+			if text != "" && len(text) < 100 {
+				authors = []string{text}
+				break
+			}
+		}
+	}
+
+	for i, author := range authors {
+		author = strings.TrimSpace(author)
+		if len(author) > 3 && strings.EqualFold(author[:3], "by ") {
+			author = strings.TrimSpace(author[3:])
+		}
+		authors[i] = author
+	}
+	return strings.Join(authors, ", ")
+}
+
+func (d *Document) published(meta *SchemaArticle) *time.Time {
+	if meta.DatePublished != nil {
+		return meta.DatePublished
+	}
+	if meta.DateCreated != nil {
+		return meta.DateCreated
+	}
+
+	candidates := []string{
+		d.metaContent("article:published_time"),
+		d.metaContent("og:published_time"),
+		d.metaContent("date"),
+		d.metaContent("pubdate"),
+		d.metaContent("publish-date"),
+		d.metaContent("dc.date"),
+	}
+	if value, ok := d.firstAttr(timeSelector, "datetime"); ok {
+		candidates = append(candidates, value)
+	}
+
+	for _, candidate := range candidates {
+		if t, ok := parseDate(candidate); ok {
+			return &t
+		}
+	}
+	return nil
+}
+
+func (d *Document) leadImage(meta *SchemaArticle, data *StructuredData, nodes []*html.Node) string {
+	var image string
+	if len(meta.Images) > 0 {
+		image = meta.Images[0]
+	}
+	if image == "" && len(data.OpenGraph.Images) > 0 {
+		image = firstNonEmpty(data.OpenGraph.Images[0].URL, data.OpenGraph.Images[0].SecureURL)
+	}
+	if image == "" {
+		image = data.TwitterCard.Image
+	}
+	if image == "" {
+		for _, node := range nodes {
+			if img := cascadia.Query(node, imageSelector); img != nil {
+				image, _ = attr(img, "src")
+				break
+			}
+		}
+	}
+	if image == "" {
+		return ""
+	}
+	return d.resolveOrKeep(image)
+}
+
+// metaContent returns the content of the meta element with the given name or property.
+func (d *Document) metaContent(name string) string {
+	for _, meta := range d.findAll(metaSelector) {
+		for _, key := range []string{"name", "property"} {
+			if value, ok := meta.Attr(key); ok && strings.EqualFold(strings.TrimSpace(value), name) {
+				content, _ := meta.Attr("content")
+				return strings.TrimSpace(content)
+			}
+		}
+	}
+	return ""
+}
+
+func (d *Document) absAttr(sel cascadia.Matcher, name string) string {
+	if value, ok := d.firstAttr(sel, name); ok {
+		return d.resolveOrKeep(value)
+	}
+	return ""
+}
+
+func firstParagraph(nodes []*html.Node) string {
+	for _, node := range nodes {
+		for _, p := range cascadia.QueryAll(node, paragraph) {
+			if text := (Element{Node: p}).Text(); len(text) >= minParagraphLength {
+				return text
+			}
+		}
+	}
+	return ""
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
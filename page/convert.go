@@ -0,0 +1,332 @@
+package page
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// boilerplate are the elements left out of the conversions: scripts, navigation and page chrome.
+var boilerplate = map[atom.Atom]bool{
+	atom.Script: true, atom.Style: true, atom.Noscript: true, atom.Template: true, atom.Head: true,
+	atom.Nav: true, atom.Footer: true, atom.Aside: true, atom.Iframe: true, atom.Svg: true, atom.Canvas: true,
+	atom.Form: true, atom.Button: true, atom.Select: true, atom.Input: true, atom.Textarea: true, atom.Dialog: true,
+}
+
+// boilerplateRoles are the ARIA roles of the elements left out of the conversions.
+var boilerplateRoles = map[string]bool{
+	"navigation": true, "banner": true, "contentinfo": true, "complementary": true, "search": true, "dialog": true,
+}
+
+// Sentinels keeping the indentation of lists and code blocks through the cleanup of the output.
+const (
+	indentSpace = "\x00"
+	indentTab   = "\x01"
+)
+
+var (
+	blankLines = regexp.MustCompile(`\n{3,}`)
+	spaces     = regexp.MustCompile(`[ \t\r\n\f]+`)
+)
+
+// Markdown converts the element to Markdown, leaving out scripts, navigation, footers, asides and forms.
+// Headings, paragraphs, lists, tables, links, images, emphasis, quotes and code are preserved, with links and
+// images resolved against the base URL of the document.
+func (e Element) Markdown() string {
+	return e.convert(true)
+}
+
+// PlainText converts the element to plain text, leaving out the same boilerplate as Markdown.
+// Blocks are separated by blank lines, list items are prefixed with "- " and table cells separated by tabs.
+func (e Element) PlainText() string {
+	return e.convert(false)
+}
+
+func (e Element) convert(markdown bool) string {
+	if e.Node == nil {
+		return ""
+	}
+	c := &converter{doc: e.doc, markdown: markdown}
+	return finish(c.node(e.Node))
+}
+
+type converter struct {
+	doc      *Document
+	markdown bool
+	pre      int
+}
+
+func (c *converter) children(node *html.Node) string {
+	var b strings.Builder
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		b.WriteString(c.node(child))
+	}
+	return b.String()
+}
+
+func (c *converter) node(node *html.Node) string {
+	switch node.Type {
+	case html.TextNode:
+		if c.pre > 0 {
+			return node.Data
+		}
+		return spaces.ReplaceAllString(node.Data, " ")
+	case html.DocumentNode:
+		return c.children(node)
+	case html.ElementNode:
+	default:
+		return ""
+	}
+
+	if isBoilerplate(node) {
+		return ""
+	}
+
+	switch node.DataAtom {
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		text := inline(c.children(node))
+		if text == "" {
+			return ""
+		}
+		if c.markdown {
+			level := int(node.Data[1] - '0')
+			text = strings.Repeat("#", level) + " " + text
+		}
+		return block(text)
+	case atom.Br:
+		return "\n"
+	case atom.Hr:
+		if c.markdown {
+			return block("---")
+		}
+		return "\n\n"
+	case atom.A:
+		return c.link(node)
+	case atom.Img:
+		return c.image(node)
+	case atom.Strong, atom.B:
+		return c.wrap(node, "**")
+	case atom.Em, atom.I:
+		return c.wrap(node, "*")
+	case atom.Del, atom.S:
+		return c.wrap(node, "~~")
+	case atom.Code:
+		if c.pre > 0 {
+			return c.children(node)
+		}
+		return c.wrap(node, "`")
+	case atom.Pre:
+		return c.preformatted(node)
+	case atom.Blockquote:
+		return c.blockquote(node)
+	case atom.Ul, atom.Ol:
+		return c.list(node)
+	case atom.Table:
+		return c.table(node)
+	}
+
+	if blocks[node.DataAtom] {
+		return block(c.children(node))
+	}
+	return c.children(node)
+}
+
+func (c *converter) link(node *html.Node) string {
+	text := inline(c.children(node))
+	href := Element{Node: node, doc: c.doc}.AbsURL("href")
+	if !c.markdown || text == "" || href == "" || strings.HasPrefix(strings.ToLower(href), "javascript:") {
+		return text
+	}
+	return "[" + text + "](" + href + ")"
+}
+
+func (c *converter) image(node *html.Node) string {
+	alt, _ := attr(node, "alt")
+	alt = inline(alt)
+	if !c.markdown {
+		return alt
+	}
+	src := Element{Node: node, doc: c.doc}.AbsURL("src")
+	if src == "" {
+		return alt
+	}
+	return "![" + alt + "](" + src + ")"
+}
+
+func (c *converter) wrap(node *html.Node, marker string) string {
+	content := c.children(node)
+	text := inline(content)
+	if !c.markdown || text == "" {
+		return content
+	}
+	// Keep the spaces around the content outside of the markers.
+	prefix, suffix := "", ""
+	if strings.HasPrefix(content, " ") {
+		prefix = " "
+	}
+	if strings.HasSuffix(content, " ") {
+		suffix = " "
+	}
+	return prefix + marker + text + marker + suffix
+}
+
+func (c *converter) preformatted(node *html.Node) string {
+	c.pre++
+	content := strings.Trim(c.children(node), "\n")
+	c.pre--
+
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimLeft(line, " \t")
+		indent := line[:len(line)-len(trimmed)]
+		indent = strings.ReplaceAll(strings.ReplaceAll(indent, " ", indentSpace), "\t", indentTab)
+		lines[i] = indent + trimmed
+	}
+	content = strings.Join(lines, "\n")
+	if c.markdown {
+		content = "```\n" + content + "\n```"
+	}
+	return block(content)
+}
+
+func (c *converter) blockquote(node *html.Node) string {
+	content := tidy(c.children(node))
+	if content == "" || !c.markdown {
+		return block(content)
+	}
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("> "+line, " ")
+	}
+	return block(strings.Join(lines, "\n"))
+}
+
+func (c *converter) list(node *html.Node) string {
+	ordered := node.DataAtom == atom.Ol
+	number := 1
+	if start, ok := attr(node, "start"); ok && ordered {
+		if n, err := strconv.Atoi(start); err == nil {
+			number = n
+		}
+	}
+
+	var items []string
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type != html.ElementNode || child.DataAtom != atom.Li || isBoilerplate(child) {
+			continue
+		}
+		marker := "- "
+		if ordered && c.markdown {
+			marker = strconv.Itoa(number) + ". "
+		}
+		number++
+
+		content := strings.ReplaceAll(tidy(c.children(child)), "\n\n", "\n")
+		lines := strings.Split(content, "\n")
+		indent := strings.Repeat(indentSpace, len(marker))
+		for i := 1; i < len(lines); i++ {
+			lines[i] = indent + lines[i]
+		}
+		items = append(items, marker+strings.Join(lines, "\n"))
+	}
+	return block(strings.Join(items, "\n"))
+}
+
+func (c *converter) table(node *html.Node) string {
+	var rows [][]string
+	var collect func(n *html.Node)
+	collect = func(n *html.Node) {
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			if child.Type != html.ElementNode {
+				continue
+			}
+			switch child.DataAtom {
+			case atom.Tr:
+				var row []string
+				for cell := child.FirstChild; cell != nil; cell = cell.NextSibling {
+					if cell.Type == html.ElementNode && (cell.DataAtom == atom.Td || cell.DataAtom == atom.Th) {
+						text := inline(c.children(cell))
+						if c.markdown {
+							text = strings.ReplaceAll(text, "|", `\|`)
+						}
+						row = append(row, text)
+					}
+				}
+				if len(row) > 0 {
+					rows = append(rows, row)
+				}
+			case atom.Thead, atom.Tbody, atom.Tfoot:
+				collect(child)
+			}
+		}
+	}
+	collect(node)
+	if len(rows) == 0 {
+		return ""
+	}
+
+	if !c.markdown {
+		lines := make([]string, len(rows))
+		for i, row := range rows {
+			lines[i] = strings.Join(row, "\t")
+		}
+		return block(strings.ReplaceAll(strings.Join(lines, "\n"), "\t", indentTab))
+	}
+
+	columns := 0
+	for _, row := range rows {
+		columns = max(columns, len(row))
+	}
+	lines := make([]string, 0, len(rows)+1)
+	for i, row := range rows {
+		for len(row) < columns {
+			row = append(row, "")
+		}
+		lines = append(lines, "| "+strings.Join(row, " | ")+" |")
+		if i == 0 {
+			lines = append(lines, "|"+strings.Repeat(" --- |", columns))
+		}
+	}
+	return block(strings.Join(lines, "\n"))
+}
+
+func isBoilerplate(node *html.Node) bool {
+	if boilerplate[node.DataAtom] {
+		return true
+	}
+	if _, hidden := attr(node, "hidden"); hidden {
+		return true
+	}
+	if ariaHidden, _ := attr(node, "aria-hidden"); ariaHidden == "true" {
+		return true
+	}
+	role, _ := attr(node, "role")
+	return boilerplateRoles[strings.ToLower(role)]
+}
+
+// block separates content from the blocks around it.
+func block(content string) string {
+	return "\n\n" + content + "\n\n"
+}
+
+// inline collapses the whitespace of inline content.
+func inline(content string) string {
+	return strings.Join(strings.Fields(content), " ")
+}
+
+// tidy trims the lines of the output and collapses blank lines.
+func tidy(content string) string {
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		lines[i] = strings.Trim(line, " \t\r\f")
+	}
+	return strings.TrimSpace(blankLines.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+}
+
+// finish tidies the output and restores the preserved indentation.
+func finish(content string) string {
+	return strings.NewReplacer(indentSpace, " ", indentTab, "\t").Replace(tidy(content))
+}
@@ -0,0 +1,114 @@
+package page_test
+
+import (
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows/page"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const articlePage = `<!DOCTYPE html>
+<html>
+<head><title>Choosing shoes</title><script>var tracking = true;</script></head>
+<body>
+	<nav><a href="/">Home</a> <a href="/blog">Blog</a></nav>
+	<div role="banner">Free shipping!</div>
+	<main>
+		<h1>Choosing   shoes</h1>
+		<p>Good shoes <strong>matter</strong>, see <a href="/guide">our guide</a>
+		or the <em>FAQ</em>.<br>Updated yearly.</p>
+		<img src="/img/shoes.png" alt="Shoes">
+		<h2>Checklist</h2>
+		<ol>
+			<li>Size</li>
+			<li>Material
+				<ul><li>Leather</li><li>Canvas</li></ul>
+			</li>
+		</ol>
+		<blockquote><p>Walk a mile in them.</p></blockquote>
+		<pre><code>fit := try(shoes)
+	if fit {
+		buy()
+	}</code></pre>
+		<table>
+			<thead><tr><th>Model</th><th>Price</th></tr></thead>
+			<tbody><tr><td>Runner | X</td><td>$50</td></tr><tr><td>Walker</td></tr></tbody>
+		</table>
+		<p hidden>Hidden text</p>
+		<form><input name="q"><button>Search</button></form>
+	</main>
+	<aside>Related posts</aside>
+	<footer>© Shop</footer>
+</body>
+</html>`
+
+func TestMarkdown(t *testing.T) {
+	doc, err := page.Parse(articlePage, "https://shop.test/blog/shoes")
+	require.NoError(t, err)
+
+	expected := "# Choosing shoes\n\n" +
+		"Good shoes **matter**, see [our guide](https://shop.test/guide) or the *FAQ*.\n" +
+		"Updated yearly.\n\n" +
+		"![Shoes](https://shop.test/img/shoes.png)\n\n" +
+		"## Checklist\n\n" +
+		"1. Size\n" +
+		"2. Material\n" +
+		"   - Leather\n" +
+		"   - Canvas\n\n" +
+		"> Walk a mile in them.\n\n" +
+		"```\n" +
+		"fit := try(shoes)\n" +
+		"\tif fit {\n" +
+		"\t\tbuy()\n" +
+		"\t}\n" +
+		"```\n\n" +
+		"| Model | Price |\n" +
+		"| --- | --- |\n" +
+		"| Runner \\| X | $50 |\n" +
+		"| Walker |  |"
+	assert.Equal(t, expected, doc.Markdown())
+}
+
+func TestPlainText(t *testing.T) {
+	doc, err := page.Parse(articlePage, "https://shop.test/blog/shoes")
+	require.NoError(t, err)
+
+	expected := "Choosing shoes\n\n" +
+		"Good shoes matter, see our guide or the FAQ.\n" +
+		"Updated yearly.\n\n" +
+		"Shoes\n\n" +
+		"Checklist\n\n" +
+		"- Size\n" +
+		"- Material\n" +
+		"  - Leather\n" +
+		"  - Canvas\n\n" +
+		"Walk a mile in them.\n\n" +
+		"fit := try(shoes)\n" +
+		"\tif fit {\n" +
+		"\t\tbuy()\n" +
+		"\t}\n\n" +
+		"Model\tPrice\n" +
+		"Runner | X\t$50\n" +
+		"Walker"
+	assert.Equal(t, expected, doc.PlainText())
+}
+
+func TestConvertElement(t *testing.T) {
+	doc, err := page.Parse(`<ol start="3"><li>Three</li><li>Four <code>x := 1</code></li></ol><a href="javascript:void(0)">js</a>`, "https://shop.test/")
+	require.NoError(t, err)
+
+	list, err := doc.First("ol")
+	require.NoError(t, err)
+	assert.Equal(t, "3. Three\n4. Four `x := 1`", list.Markdown())
+
+	link, err := doc.First("a")
+	require.NoError(t, err)
+	assert.Equal(t, "js", link.Markdown())
+
+	missing, err := doc.First("table")
+	require.NoError(t, err)
+	assert.Empty(t, missing.Markdown())
+	assert.Empty(t, missing.PlainText())
+}
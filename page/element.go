@@ -0,0 +1,167 @@
+package page
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/andybalholm/cascadia"
+	"golang.org/x/net/html"
+)
+
+// Element is an element of a Document.
+type Element struct {
+	Node *html.Node
+	doc  *Document
+}
+
+// Find returns the elements matching the CSS selector, in document order.
+func (e Element) Find(selector string) ([]Element, error) {
+	sel, err := compile(selector)
+	if err != nil {
+		return nil, err
+	}
+	return e.findAll(sel), nil
+}
+
+// First returns the first element matching the CSS selector, the zero Element when there's none, see Element.Exists.
+func (e Element) First(selector string) (Element, error) {
+	sel, err := compile(selector)
+	if err != nil {
+		return Element{}, err
+	}
+	if e.Node == nil {
+		return Element{}, nil
+	}
+	node := cascadia.Query(e.Node, sel)
+	if node == nil {
+		return Element{}, nil
+	}
+	return Element{Node: node, doc: e.doc}, nil
+}
+
+// Texts returns the text of each element matching the CSS selector.
+func (e Element) Texts(selector string) ([]string, error) {
+	elements, err := e.Find(selector)
+	if err != nil {
+		return nil, err
+	}
+	texts := make([]string, 0, len(elements))
+	for _, el := range elements {
+		texts = append(texts, el.Text())
+	}
+	return texts, nil
+}
+
+// Attrs returns the attribute of each element matching the CSS selector that has it.
+func (e Element) Attrs(selector, name string) ([]string, error) {
+	elements, err := e.Find(selector)
+	if err != nil {
+		return nil, err
+	}
+	var values []string
+	for _, el := range elements {
+		if value, ok := el.Attr(name); ok {
+			values = append(values, value)
+		}
+	}
+	return values, nil
+}
+
+// Exists reports whether the element exists, i.e. it isn't the zero Element returned by First.
+func (e Element) Exists() bool {
+	return e.Node != nil
+}
+
+// Tag returns the tag name of the element.
+func (e Element) Tag() string {
+	if e.Node == nil {
+		return ""
+	}
+	return e.Node.Data
+}
+
+// Attr returns the value of an attribute of the element.
+func (e Element) Attr(name string) (string, bool) {
+	if e.Node == nil {
+		return "", false
+	}
+	return attr(e.Node, name)
+}
+
+// AbsURL returns the URL held by an attribute of the element, such as href or src, resolved against the base URL
+// of the document; an empty string when the element doesn't have the attribute or it isn't a valid URL.
+func (e Element) AbsURL(name string) string {
+	value, ok := e.Attr(name)
+	if !ok {
+		return ""
+	}
+	abs, err := e.doc.Resolve(value)
+	if err != nil {
+		return ""
+	}
+	return abs
+}
+
+// Text returns the text content of the element, without scripts and styles and with whitespace collapsed.
+func (e Element) Text() string {
+	if e.Node == nil {
+		return ""
+	}
+	var b strings.Builder
+	writeText(&b, e.Node)
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// HTML returns the HTML of the element, including the element itself.
+func (e Element) HTML() string {
+	if e.Node == nil {
+		return ""
+	}
+	var b strings.Builder
+	// Rendering to a strings.Builder doesn't fail.
+	_ = html.Render(&b, e.Node)
+	return b.String()
+}
+
+func (e Element) findAll(sel cascadia.Matcher) []Element {
+	if e.Node == nil {
+		return nil
+	}
+	nodes := cascadia.QueryAll(e.Node, sel)
+	elements := make([]Element, 0, len(nodes))
+	for _, node := range nodes {
+		elements = append(elements, Element{Node: node, doc: e.doc})
+	}
+	return elements
+}
+
+func writeText(b *strings.Builder, node *html.Node) {
+	switch node.Type {
+	case html.TextNode:
+		b.WriteString(node.Data)
+		return
+	case html.ElementNode:
+		if skipText[node.DataAtom] {
+			return
+		}
+	}
+
+	block := node.Type == html.ElementNode && blocks[node.DataAtom]
+	if block {
+		b.WriteByte(' ')
+	}
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		writeText(b, child)
+	}
+	if block {
+		b.WriteByte(' ')
+	}
+}
+
+func compile(selector string) (cascadia.Sel, error) {
+	sel, err := cascadia.Parse(selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector %q: %w", selector, err)
+	}
+	return sel, nil
+}
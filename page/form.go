@@ -0,0 +1,169 @@
+package page
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Form is a form of a Document.
+type Form struct {
+	Element
+	// ID is the id attribute of the form.
+	ID string
+	// Name is the name attribute of the form.
+	Name string
+	// Action is the absolute URL the form is submitted to.
+	Action string
+	// Method is the HTTP method of the form, GET or POST.
+	Method string
+	// Enctype is the encoding of the form data for POST forms.
+	Enctype string
+	// Fields are the named fields of the form, in document order.
+	Fields []Field
+}
+
+// Field is a named field of a Form.
+type Field struct {
+	// Name is the name the value is submitted under.
+	Name string
+	// Type is the type of an input, such as text, hidden or checkbox, or the tag of other fields:
+	// select, textarea or button.
+	Type string
+	// Value is the current value of the field, the selected one for selects.
+	Value string
+	// Options are the values of the options of a select.
+	Options []string
+	// Checked reports whether a checkbox or radio input is checked.
+	Checked bool
+	// Required reports whether the field is required.
+	Required bool
+	// Disabled reports whether the field is disabled, disabled fields aren't submitted.
+	Disabled bool
+}
+
+// Forms returns the forms of the page.
+func (d *Document) Forms() []Form {
+	var forms []Form
+	for _, el := range d.findAll(formSelector) {
+		forms = append(forms, d.newForm(el))
+	}
+	return forms
+}
+
+// Form returns the first form matching the CSS selector, e.g. "#search" or "form[action*=login]".
+func (d *Document) Form(selector string) (Form, bool, error) {
+	el, err := d.First(selector)
+	if err != nil || !el.Exists() || el.Node.Data != "form" {
+		return Form{}, false, err
+	}
+	return d.newForm(el), true, nil
+}
+
+func (d *Document) newForm(el Element) Form {
+	form := Form{
+		Element: el,
+		Method:  http.MethodGet,
+		Action:  d.base.String(),
+		Fields:  []Field{},
+	}
+	form.ID, _ = el.Attr("id")
+	form.Name, _ = el.Attr("name")
+	if method, _ := el.Attr("method"); strings.EqualFold(strings.TrimSpace(method), http.MethodPost) {
+		form.Method = http.MethodPost
+		form.Enctype = "application/x-www-form-urlencoded"
+		if enctype, ok := el.Attr("enctype"); ok && enctype != "" {
+			form.Enctype = strings.ToLower(enctype)
+		}
+	}
+	if action, ok := el.Attr("action"); ok && strings.TrimSpace(action) != "" {
+		form.Action = el.AbsURL("action")
+	}
+
+	for _, field := range el.findAll(fieldSelector) {
+		form.Fields = append(form.Fields, newField(field))
+	}
+	return form
+}
+
+func newField(el Element) Field {
+	_, required := el.Attr("required")
+	_, disabled := el.Attr("disabled")
+	field := Field{Type: el.Node.Data, Required: required, Disabled: disabled}
+	field.Name, _ = el.Attr("name")
+
+	switch el.Node.Data {
+	case "input":
+		field.Type = "text"
+		if typ, ok := el.Attr("type"); ok && typ != "" {
+			field.Type = strings.ToLower(typ)
+		}
+		field.Value, _ = el.Attr("value")
+		if field.Type == "checkbox" || field.Type == "radio" {
+			_, field.Checked = el.Attr("checked")
+			if _, ok := el.Attr("value"); !ok {
+				field.Value = "on"
+			}
+		}
+	case "textarea":
+		field.Value = textContent(el.Node)
+	case "button":
+		field.Value, _ = el.Attr("value")
+	case "select":
+		for i, option := range el.findAll(optionSelector) {
+			value, ok := option.Attr("value")
+			if !ok {
+				value = option.Text()
+			}
+			field.Options = append(field.Options, value)
+			if _, selected := option.Attr("selected"); selected || i == 0 {
+				field.Value = value
+			}
+		}
+	}
+	return field
+}
+
+// Values returns the values the form would submit without user input: the enabled fields with their current value,
+// only checked checkboxes and radios, and without buttons and file inputs.
+func (f Form) Values() url.Values {
+	values := url.Values{}
+	for _, field := range f.Fields {
+		if field.Disabled {
+			continue
+		}
+		switch field.Type {
+		case "button", "submit", "reset", "image", "file":
+			continue
+		case "checkbox", "radio":
+			if !field.Checked {
+				continue
+			}
+		}
+		values.Add(field.Name, field.Value)
+	}
+	return values
+}
+
+// SubmitURL returns the URL of a GET submission of the form with the given values.
+func (f Form) SubmitURL(values url.Values) string {
+	u, err := url.Parse(f.Action)
+	if err != nil {
+		return f.Action
+	}
+	u.RawQuery = values.Encode()
+	return u.String()
+}
+
+// textContent returns the raw text of a node, such as the default value of a textarea.
+func textContent(node *html.Node) string {
+	var b strings.Builder
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.TextNode {
+			b.WriteString(child.Data)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,92 @@
+package page_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows/page"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const forms = `<html><body>
+<form id="search" action="/search">
+	<input name="q" value="shoes" required>
+	<input type="hidden" name="lang" value="en">
+	<select name="sort">
+		<option value="relevance">Relevance</option>
+		<option value="price" selected>Price</option>
+	</select>
+	<input type="checkbox" name="in_stock" checked>
+	<input type="checkbox" name="on_sale" value="1">
+	<input type="radio" name="size" value="m">
+	<input type="radio" name="size" value="l" checked>
+	<input name="legacy" value="x" disabled>
+	<button type="submit" name="go" value="1">Search</button>
+</form>
+<form name="login" method="post" enctype="multipart/form-data">
+	<input type="email" name="email">
+	<textarea name="note">Hello</textarea>
+	<input type="file" name="avatar">
+</form>
+</body></html>`
+
+func TestForms(t *testing.T) {
+	doc, err := page.Parse(forms, "https://shop.test/home")
+	require.NoError(t, err)
+
+	all := doc.Forms()
+	require.Len(t, all, 2)
+
+	search := all[0]
+	assert.Equal(t, "search", search.ID)
+	assert.Equal(t, http.MethodGet, search.Method)
+	assert.Equal(t, "https://shop.test/search", search.Action)
+	assert.Empty(t, search.Enctype)
+	require.Len(t, search.Fields, 9)
+	assert.Equal(t, page.Field{Name: "q", Type: "text", Value: "shoes", Required: true}, search.Fields[0])
+	assert.Equal(t, page.Field{Name: "sort", Type: "select", Value: "price", Options: []string{"relevance", "price"}}, search.Fields[2])
+	assert.Equal(t, page.Field{Name: "in_stock", Type: "checkbox", Value: "on", Checked: true}, search.Fields[3])
+
+	values := search.Values()
+	assert.Equal(t, url.Values{
+		"q":        {"shoes"},
+		"lang":     {"en"},
+		"sort":     {"price"},
+		"in_stock": {"on"},
+		"size":     {"l"},
+	}, values)
+
+	values.Set("q", "boots")
+	assert.Equal(t, "https://shop.test/search?in_stock=on&lang=en&q=boots&size=l&sort=price", search.SubmitURL(values))
+
+	login := all[1]
+	assert.Equal(t, "login", login.Name)
+	assert.Equal(t, http.MethodPost, login.Method)
+	assert.Equal(t, "https://shop.test/home", login.Action)
+	assert.Equal(t, "multipart/form-data", login.Enctype)
+	assert.Equal(t, url.Values{"email": {""}, "note": {"Hello"}}, login.Values())
+}
+
+func TestForm(t *testing.T) {
+	doc, err := page.Parse(forms, "https://shop.test/home")
+	require.NoError(t, err)
+
+	form, ok, err := doc.Form("form[name=login]")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "login", form.Name)
+
+	_, ok, err = doc.Form("#missing")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, ok, err = doc.Form("select")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, _, err = doc.Form("[[")
+	assert.Error(t, err)
+}
@@ -0,0 +1,208 @@
+package page
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// urlProperties are the attributes holding the value of microdata properties whose value is a URL, by tag.
+var urlProperties = map[string]string{
+	"a": "href", "area": "href", "link": "href",
+	"audio": "src", "embed": "src", "iframe": "src", "img": "src", "source": "src", "track": "src", "video": "src",
+	"object": "data",
+}
+
+// microdata returns the top-level microdata items of the page: the elements with itemscope but without itemprop.
+func (d *Document) microdata() []*Item {
+	var items []*Item
+	walk(d.Node, func(node *html.Node) bool {
+		if !hasAttr(node, "itemscope") {
+			return true
+		}
+		if !hasAttr(node, "itemprop") {
+			items = append(items, d.microdataItem(node, map[*html.Node]bool{}))
+		}
+		// Nested items are reached through the properties of their parent.
+		return false
+	})
+	return items
+}
+
+// microdataItem builds the item of an itemscope element, visiting guards against itemref cycles.
+func (d *Document) microdataItem(node *html.Node, visiting map[*html.Node]bool) *Item {
+	visiting[node] = true
+	defer delete(visiting, node)
+
+	item := &Item{Properties: map[string][]any{}}
+	itemType, _ := attr(node, "itemtype")
+	item.Type = strings.Fields(itemType)
+	item.ID, _ = attr(node, "itemid")
+	if item.ID != "" {
+		item.ID = d.resolveOrKeep(item.ID)
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		d.microdataProperties(child, item, visiting)
+	}
+	refs, _ := attr(node, "itemref")
+	for _, id := range strings.Fields(refs) {
+		if ref := findByID(d.Node, id); ref != nil && !visiting[ref] {
+			d.microdataProperties(ref, item, visiting)
+		}
+	}
+	return item
+}
+
+func (d *Document) microdataProperties(node *html.Node, item *Item, visiting map[*html.Node]bool) {
+	if node.Type != html.ElementNode {
+		return
+	}
+	if names, ok := attr(node, "itemprop"); ok {
+		value := d.microdataValue(node, visiting)
+		for _, name := range strings.Fields(names) {
+			item.Properties[name] = append(item.Properties[name], value)
+		}
+	}
+	if hasAttr(node, "itemscope") {
+		return
+	}
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		d.microdataProperties(child, item, visiting)
+	}
+}
+
+func (d *Document) microdataValue(node *html.Node, visiting map[*html.Node]bool) any {
+	if hasAttr(node, "itemscope") {
+		if visiting[node] {
+			id, _ := attr(node, "itemid")
+			return id
+		}
+		return d.microdataItem(node, visiting)
+	}
+
+	switch node.Data {
+	case "meta":
+		content, _ := attr(node, "content")
+		return content
+	case "data", "meter":
+		value, _ := attr(node, "value")
+		return value
+	case "time":
+		if datetime, ok := attr(node, "datetime"); ok {
+			return datetime
+		}
+	default:
+		if name, ok := urlProperties[node.Data]; ok {
+			value, _ := attr(node, name)
+			return d.resolveOrKeep(value)
+		}
+	}
+	return Element{Node: node, doc: d}.Text()
+}
+
+// rdfa returns the top-level RDFa Lite items of the page: the elements with typeof that aren't the value
+// of a property of another item.
+func (d *Document) rdfa() []*Item {
+	var items []*Item
+	for child := d.Node.FirstChild; child != nil; child = child.NextSibling {
+		d.rdfaWalk(child, "", nil, &items)
+	}
+	return items
+}
+
+func (d *Document) rdfaWalk(node *html.Node, vocab string, parent *Item, items *[]*Item) {
+	if node.Type != html.ElementNode {
+		return
+	}
+	if v, ok := attr(node, "vocab"); ok {
+		vocab = strings.TrimSpace(v)
+	}
+	property, _ := attr(node, "property")
+	names := strings.Fields(property)
+
+	if types, ok := attr(node, "typeof"); ok {
+		item := &Item{Properties: map[string][]any{}}
+		for _, typ := range strings.Fields(types) {
+			if vocab != "" && !strings.Contains(typ, ":") {
+				typ = vocab + typ
+			}
+			item.Type = append(item.Type, typ)
+		}
+		if resource, ok := attr(node, "resource"); ok {
+			item.ID = d.resolveOrKeep(resource)
+		}
+
+		if parent != nil && len(names) > 0 {
+			for _, name := range names {
+				parent.Properties[name] = append(parent.Properties[name], item)
+			}
+		} else {
+			*items = append(*items, item)
+		}
+		parent = item
+	} else if parent != nil && len(names) > 0 {
+		value := d.rdfaValue(node)
+		for _, name := range names {
+			parent.Properties[name] = append(parent.Properties[name], value)
+		}
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		d.rdfaWalk(child, vocab, parent, items)
+	}
+}
+
+func (d *Document) rdfaValue(node *html.Node) string {
+	if content, ok := attr(node, "content"); ok {
+		return content
+	}
+	for _, name := range []string{"resource", "href", "src"} {
+		if value, ok := attr(node, name); ok {
+			return d.resolveOrKeep(value)
+		}
+	}
+	if datetime, ok := attr(node, "datetime"); ok && node.Data == "time" {
+		return datetime
+	}
+	return Element{Node: node, doc: d}.Text()
+}
+
+// resolveOrKeep resolves a URL against the base URL of the page, keeping it as it is when it isn't a valid URL.
+func (d *Document) resolveOrKeep(ref string) string {
+	abs, err := d.Resolve(ref)
+	if err != nil {
+		return ref
+	}
+	return abs
+}
+
+// walk visits the elements of the tree in document order, visit returns whether to visit the children.
+func walk(node *html.Node, visit func(node *html.Node) bool) {
+	if node.Type == html.ElementNode && !visit(node) {
+		return
+	}
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		walk(child, visit)
+	}
+}
+
+func findByID(root *html.Node, id string) *html.Node {
+	var found *html.Node
+	walk(root, func(node *html.Node) bool {
+		if found != nil {
+			return false
+		}
+		if value, ok := attr(node, "id"); ok && value == id {
+			found = node
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+func hasAttr(node *html.Node, name string) bool {
+	_, ok := attr(node, name)
+	return ok
+}
@@ -0,0 +1,149 @@
+// Package page parses scraped HTML once and queries it with CSS selectors: text and attribute extraction,
+// links resolved against the final URL of the page, and form discovery.
+//
+//	resp, err := client.Execute(ctx, &zenrows.Call{TargetURL: targetURL})
+//	...
+//	doc, err := page.FromResponse(resp, targetURL)
+//	...
+//	titles, err := doc.Find("article h2")
+//	for _, title := range titles {
+//		link, _ := title.First("a")
+//		fmt.Println(title.Text(), link.AbsURL("href"))
+//	}
+package page
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/andybalholm/cascadia"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/renatoaraujo/go-zenrows"
+)
+
+var (
+	baseSelector   = cascadia.MustCompile("base[href]")
+	titleSelector  = cascadia.MustCompile("title")
+	linkSelector   = cascadia.MustCompile("a[href]")
+	formSelector   = cascadia.MustCompile("form")
+	fieldSelector  = cascadia.MustCompile("input[name], select[name], textarea[name], button[name]")
+	optionSelector = cascadia.MustCompile("option")
+)
+
+// Document is a parsed HTML page.
+type Document struct {
+	Element
+	base *url.URL
+}
+
+// Parse parses an HTML page, relative URLs are resolved against baseURL, or the <base> of the page if any.
+func Parse(body, baseURL string) (*Document, error) {
+	root, err := html.Parse(strings.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse html: %w", err)
+	}
+
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse base url: %w", err)
+	}
+	doc := &Document{base: base}
+	doc.Element = Element{Node: root, doc: doc}
+
+	if href, ok := doc.firstAttr(baseSelector, "href"); ok {
+		if ref, err := url.Parse(strings.TrimSpace(href)); err == nil {
+			doc.base = base.ResolveReference(ref)
+		}
+	}
+	return doc, nil
+}
+
+// FromResponse parses the page scraped from targetURL, relative URLs are resolved against the final URL reported
+// by ZenRows after redirects, or targetURL.
+func FromResponse(resp *zenrows.Response, targetURL string) (*Document, error) {
+	base := resp.FinalURL()
+	if base == "" {
+		base = targetURL
+	}
+	return Parse(resp.Body, base)
+}
+
+// BaseURL returns the URL relative URLs of the page are resolved against.
+func (d *Document) BaseURL() *url.URL {
+	return d.base
+}
+
+// Resolve returns ref as an absolute URL, resolved against the base URL of the page.
+func (d *Document) Resolve(ref string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(ref))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse url %q: %w", ref, err)
+	}
+	return d.base.ResolveReference(u).String(), nil
+}
+
+// Title returns the text of the <title> of the page.
+func (d *Document) Title() string {
+	for _, el := range d.findAll(titleSelector) {
+		return el.Text()
+	}
+	return ""
+}
+
+// Links returns the absolute URLs of the <a href> links of the page, without duplicates or fragments,
+// ignoring javascript: and mailto: links.
+func (d *Document) Links() []string {
+	var links []string
+	seen := map[string]struct{}{}
+	for _, el := range d.findAll(linkSelector) {
+		link := el.AbsURL("href")
+		u, err := url.Parse(link)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			continue
+		}
+		u.Fragment = ""
+		link = u.String()
+		if _, ok := seen[link]; ok {
+			continue
+		}
+		seen[link] = struct{}{}
+		links = append(links, link)
+	}
+	return links
+}
+
+func (d *Document) firstAttr(sel cascadia.Matcher, name string) (string, bool) {
+	node := cascadia.Query(d.Node, sel)
+	if node == nil {
+		return "", false
+	}
+	return attr(node, name)
+}
+
+func attr(node *html.Node, name string) (string, bool) {
+	for _, a := range node.Attr {
+		if a.Namespace == "" && a.Key == name {
+			return a.Val, true
+		}
+	}
+	return "", false
+}
+
+// skipText are the elements whose content isn't text.
+var skipText = map[atom.Atom]bool{
+	atom.Script: true, atom.Style: true, atom.Noscript: true, atom.Template: true, atom.Head: true,
+}
+
+// blocks are the elements whose content is separated from the text around it.
+var blocks = map[atom.Atom]bool{
+	atom.Address: true, atom.Article: true, atom.Aside: true, atom.Blockquote: true, atom.Br: true,
+	atom.Dd: true, atom.Div: true, atom.Dl: true, atom.Dt: true, atom.Fieldset: true, atom.Figcaption: true,
+	atom.Figure: true, atom.Footer: true, atom.Form: true, atom.H1: true, atom.H2: true, atom.H3: true,
+	atom.H4: true, atom.H5: true, atom.H6: true, atom.Header: true, atom.Hr: true, atom.Li: true,
+	atom.Main: true, atom.Nav: true, atom.Ol: true, atom.Option: true, atom.P: true, atom.Pre: true,
+	atom.Section: true, atom.Table: true, atom.Td: true, atom.Th: true, atom.Title: true, atom.Tr: true,
+	atom.Ul: true,
+}
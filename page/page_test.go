@@ -0,0 +1,155 @@
+package page_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	"github.com/renatoaraujo/go-zenrows/page"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const productList = `<!DOCTYPE html>
+<html>
+<head>
+	<title> Running shoes | Shop </title>
+	<style>.price { color: red }</style>
+</head>
+<body>
+	<nav><a href="/">Home</a> <a href="mailto:help@shop.test">Help</a> <a href="javascript:void(0)">Menu</a></nav>
+	<ul class="products">
+		<li class="product" data-sku="R1">
+			<a href="p/red-shoes#reviews"><h2>Red <b>shoes</b></h2></a>
+			<span class="price">$50</span>
+			<img src="/img/red.png">
+		</li>
+		<li class="product" data-sku="B2">
+			<a href="p/blue-shoes"><h2>Blue shoes</h2></a>
+			<span class="price">$60</span>
+		</li>
+	</ul>
+	<p>Page<br>1 of 3</p>
+	<a href="https://shop.test/shoes/p/red-shoes">Again</a>
+	<script>console.log("tracking")</script>
+</body>
+</html>`
+
+func TestParse(t *testing.T) {
+	doc, err := page.Parse(productList, "https://shop.test/shoes/")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Running shoes | Shop", doc.Title())
+	assert.Equal(t, "https://shop.test/shoes/", doc.BaseURL().String())
+	assert.Equal(t, []string{
+		"https://shop.test/",
+		"https://shop.test/shoes/p/red-shoes",
+		"https://shop.test/shoes/p/blue-shoes",
+	}, doc.Links())
+
+	products, err := doc.Find(".product")
+	require.NoError(t, err)
+	require.Len(t, products, 2)
+
+	name, err := products[0].First("h2")
+	require.NoError(t, err)
+	assert.Equal(t, "Red shoes", name.Text())
+	assert.Equal(t, "<h2>Red <b>shoes</b></h2>", name.HTML())
+	assert.Equal(t, "h2", name.Tag())
+
+	sku, ok := products[0].Attr("data-sku")
+	assert.True(t, ok)
+	assert.Equal(t, "R1", sku)
+
+	img, err := products[0].First("img")
+	require.NoError(t, err)
+	assert.Equal(t, "https://shop.test/img/red.png", img.AbsURL("src"))
+	assert.Empty(t, img.AbsURL("alt"))
+
+	missing, err := products[1].First("img")
+	require.NoError(t, err)
+	assert.False(t, missing.Exists())
+	assert.Empty(t, missing.Text())
+	assert.Empty(t, missing.AbsURL("src"))
+
+	prices, err := doc.Texts(".price")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"$50", "$60"}, prices)
+
+	skus, err := doc.Attrs("li", "data-sku")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"R1", "B2"}, skus)
+
+	paging, err := doc.First("p")
+	require.NoError(t, err)
+	assert.Equal(t, "Page 1 of 3", paging.Text())
+
+	body, err := doc.First("body")
+	require.NoError(t, err)
+	assert.NotContains(t, body.Text(), "tracking")
+	assert.NotContains(t, doc.Text(), "color: red")
+}
+
+func TestFromResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   http.Header
+		body     string
+		expected string
+	}{
+		{"target url", http.Header{}, `<a href="next">next</a>`, "https://shop.test/a/next"},
+		{"final url", http.Header{"Zr-Final-Url": {"https://shop.test/b/"}}, `<a href="next">next</a>`, "https://shop.test/b/next"},
+		{
+			"base element",
+			http.Header{"Zr-Final-Url": {"https://shop.test/b/"}},
+			`<head><base href="/c/"></head><a href="next">next</a>`,
+			"https://shop.test/c/next",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := page.FromResponse(&zenrows.Response{Header: tt.header, Body: tt.body}, "https://shop.test/a/")
+			require.NoError(t, err)
+			assert.Equal(t, []string{tt.expected}, doc.Links())
+		})
+	}
+}
+
+func TestMissingElement(t *testing.T) {
+	doc, err := page.Parse(productList, "https://shop.test/")
+	require.NoError(t, err)
+
+	missing, err := doc.First(".missing")
+	require.NoError(t, err)
+	assert.False(t, missing.Exists())
+
+	nested, err := missing.First("a")
+	require.NoError(t, err)
+	assert.False(t, nested.Exists())
+	elements, err := missing.Find("a")
+	require.NoError(t, err)
+	assert.Empty(t, elements)
+	assert.Empty(t, missing.Text())
+
+	_, err = missing.First("[[")
+	assert.Error(t, err)
+}
+
+func TestInvalidSelector(t *testing.T) {
+	doc, err := page.Parse(productList, "https://shop.test/")
+	require.NoError(t, err)
+
+	_, err = doc.Find("[[")
+	assert.ErrorContains(t, err, `invalid selector "[["`)
+	_, err = doc.First("[[")
+	assert.Error(t, err)
+	_, err = doc.Texts("[[")
+	assert.Error(t, err)
+	_, err = doc.Attrs("[[", "href")
+	assert.Error(t, err)
+
+	_, err = page.Parse(productList, "://invalid")
+	assert.ErrorContains(t, err, "failed to parse base url")
+}
@@ -0,0 +1,310 @@
+package page
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// ErrNoArticle is returned by Document.Article when no main content could be found in the page.
+var ErrNoArticle = errors.New("no article content found")
+
+// Patterns of the class and id attributes telling content and page chrome apart, after Mozilla's Readability.
+var (
+	unlikelyCandidate = regexp.MustCompile(`(?i)-ad-|ai2html|banner|breadcrumbs|combx|comment|community|cookie|cover-wrap|disqus|extra|footer|gdpr|header|legends|menu|newsletter|related|remark|replies|rss|share|shoutbox|sidebar|skyscraper|social|sponsor|supplemental|ad-break|agegate|pagination|pager|popup|subscribe`)
+	maybeCandidate    = regexp.MustCompile(`(?i)and|article|body|column|content|main|shadow`)
+	positiveName      = regexp.MustCompile(`(?i)article|body|content|entry|hentry|h-entry|main|page|post|text|blog|story`)
+	negativeName      = regexp.MustCompile(`(?i)-ad-|hidden|^hid$|banner|combx|comment|com-|contact|foot|footer|footnote|gdpr|masthead|media|meta|outbrain|promo|related|scroll|share|shoutbox|sidebar|skyscraper|sponsor|shopping|tags|tool|widget|byline|author|dateline|writtenby`)
+)
+
+// minParagraphLength is the length under which paragraphs don't count towards the score of their ancestors.
+const minParagraphLength = 25
+
+// scoredTags are the elements whose text scores their ancestors.
+var scoredTags = map[atom.Atom]bool{
+	atom.P: true, atom.Pre: true, atom.Td: true, atom.Section: true,
+	atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true,
+}
+
+// mediaTags are the elements kept by the cleanup even when they have no text.
+var mediaTags = map[atom.Atom]bool{
+	atom.Img: true, atom.Picture: true, atom.Video: true, atom.Audio: true, atom.Figure: true, atom.Source: true,
+	atom.Br: true, atom.Hr: true,
+}
+
+// readability finds the nodes holding the main content of a page by scoring them on the amount of text they hold.
+type readability struct {
+	doc    *Document
+	scores map[*html.Node]float64
+}
+
+// content returns the nodes of the main content, the best scored node and its related siblings,
+// cleaned up of the page chrome they contain.
+func (r *readability) content() []*html.Node {
+	r.scores = map[*html.Node]float64{}
+	r.score(r.doc.Node)
+
+	var top *html.Node
+	for node, score := range r.scores {
+		score *= 1 - linkDensity(node)
+		r.scores[node] = score
+		if top == nil || score > r.scores[top] || (score == r.scores[top] && isBefore(node, top)) {
+			top = node
+		}
+	}
+	if top == nil {
+		return nil
+	}
+
+	var nodes []*html.Node
+	for _, node := range r.siblings(top) {
+		clone := cloneNode(node)
+		r.clean(clone)
+		if textLength(clone) > 0 || hasMedia(clone) {
+			nodes = append(nodes, clone)
+		}
+	}
+	return nodes
+}
+
+// score walks the tree, giving each paragraph's ancestors a share of its score.
+func (r *readability) score(node *html.Node) {
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type != html.ElementNode || isBoilerplate(child) || isUnlikely(child) {
+			continue
+		}
+		if scoredTags[child.DataAtom] || (child.DataAtom == atom.Div && !hasBlockChildren(child)) {
+			r.scoreParagraph(child)
+		}
+		r.score(child)
+	}
+}
+
+func (r *readability) scoreParagraph(node *html.Node) {
+	text := Element{Node: node, doc: r.doc}.Text()
+	length := len([]rune(text))
+	if length < minParagraphLength {
+		return
+	}
+	score := 1 + float64(strings.Count(text, ",")) + math.Min(float64(length/100), 3)
+
+	ancestor := node.Parent
+	for level := 0; level < 3 && ancestor != nil && ancestor.Type == html.ElementNode; level++ {
+		if ancestor.DataAtom == atom.Body || ancestor.DataAtom == atom.Html {
+			break
+		}
+		if _, ok := r.scores[ancestor]; !ok {
+			r.scores[ancestor] = initialScore(ancestor)
+		}
+		divider := 1.0
+		if level == 1 {
+			divider = 2
+		} else if level > 1 {
+			divider = float64(level * 3)
+		}
+		r.scores[ancestor] += score / divider
+		ancestor = ancestor.Parent
+	}
+}
+
+// siblings returns top along with the siblings that look like part of the same content.
+func (r *readability) siblings(top *html.Node) []*html.Node {
+	if top.Parent == nil {
+		return []*html.Node{top}
+	}
+	threshold := math.Max(10, r.scores[top]*0.2)
+	topClass, _ := attr(top, "class")
+
+	var nodes []*html.Node
+	for sibling := top.Parent.FirstChild; sibling != nil; sibling = sibling.NextSibling {
+		if sibling == top {
+			nodes = append(nodes, sibling)
+			continue
+		}
+		if sibling.Type != html.ElementNode || isBoilerplate(sibling) || isUnlikely(sibling) {
+			continue
+		}
+
+		score, scored := r.scores[sibling]
+		if class, _ := attr(sibling, "class"); class != "" && class == topClass {
+			score += r.scores[top] * 0.2
+		}
+		if scored && score >= threshold {
+			nodes = append(nodes, sibling)
+			continue
+		}
+		if sibling.DataAtom == atom.P {
+			text := Element{Node: sibling, doc: r.doc}.Text()
+			density := linkDensity(sibling)
+			length := len([]rune(text))
+			if (length > 80 && density < 0.25) || (length > 0 && density == 0 && strings.HasSuffix(text, ".")) {
+				nodes = append(nodes, sibling)
+			}
+		}
+	}
+	return nodes
+}
+
+// clean removes the page chrome from the descendants of node: boilerplate, unlikely and negatively named elements,
+// link lists and empty elements.
+func (r *readability) clean(node *html.Node) {
+	for child := node.FirstChild; child != nil; {
+		next := child.NextSibling
+		if child.Type == html.ElementNode {
+			if r.removable(child) {
+				node.RemoveChild(child)
+			} else {
+				r.clean(child)
+				if textLength(child) == 0 && !hasMedia(child) {
+					node.RemoveChild(child)
+				}
+			}
+		} else if child.Type == html.CommentNode {
+			node.RemoveChild(child)
+		}
+		child = next
+	}
+}
+
+func (r *readability) removable(node *html.Node) bool {
+	if isBoilerplate(node) || isUnlikely(node) {
+		return true
+	}
+	switch node.DataAtom {
+	case atom.Div, atom.Section, atom.Ul, atom.Ol, atom.Table, atom.Header:
+		if classWeight(node) < 0 {
+			return true
+		}
+		length := textLength(node)
+		density := linkDensity(node)
+		return density > 0.5 && length < 200 || (node.DataAtom != atom.Table && density > 0.33 && length < 50)
+	}
+	return false
+}
+
+func initialScore(node *html.Node) float64 {
+	score := classWeight(node)
+	switch node.DataAtom {
+	case atom.Div, atom.Article, atom.Main:
+		score += 5
+	case atom.Pre, atom.Td, atom.Blockquote:
+		score += 3
+	case atom.Address, atom.Ol, atom.Ul, atom.Dl, atom.Dd, atom.Dt, atom.Li, atom.Form:
+		score -= 3
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Th:
+		score -= 5
+	}
+	return score
+}
+
+// classWeight scores the class and id of an element for looking like content or like page chrome.
+func classWeight(node *html.Node) float64 {
+	var weight float64
+	for _, name := range []string{"class", "id"} {
+		value, _ := attr(node, name)
+		if value == "" {
+			continue
+		}
+		if negativeName.MatchString(value) {
+			weight -= 25
+		}
+		if positiveName.MatchString(value) {
+			weight += 25
+		}
+	}
+	return weight
+}
+
+// isUnlikely reports whether the class or id of an element looks like page chrome.
+func isUnlikely(node *html.Node) bool {
+	switch node.DataAtom {
+	case atom.Body, atom.Html, atom.Article, atom.Main, atom.A:
+		return false
+	}
+	class, _ := attr(node, "class")
+	id, _ := attr(node, "id")
+	names := class + " " + id
+	return unlikelyCandidate.MatchString(names) && !maybeCandidate.MatchString(names)
+}
+
+func hasBlockChildren(node *html.Node) bool {
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.ElementNode && blocks[child.DataAtom] && child.DataAtom != atom.Br {
+			return true
+		}
+	}
+	return false
+}
+
+func hasMedia(node *html.Node) bool {
+	if node.Type == html.ElementNode && mediaTags[node.DataAtom] {
+		return true
+	}
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if hasMedia(child) {
+			return true
+		}
+	}
+	return false
+}
+
+func textLength(node *html.Node) int {
+	return len([]rune(Element{Node: node}.Text()))
+}
+
+// linkDensity is the share of the text of an element that is in links.
+func linkDensity(node *html.Node) float64 {
+	length := textLength(node)
+	if length == 0 {
+		return 0
+	}
+	links := 0
+	walk(node, func(n *html.Node) bool {
+		if n.DataAtom == atom.A {
+			links += textLength(n)
+			return false
+		}
+		return true
+	})
+	return float64(links) / float64(length)
+}
+
+// isBefore reports whether a comes before b in document order, to break ties deterministically.
+func isBefore(a, b *html.Node) bool {
+	found, before := false, false
+	walk(rootOf(a), func(n *html.Node) bool {
+		if found {
+			return false
+		}
+		if n == a || n == b {
+			found, before = true, n == a
+		}
+		return !found
+	})
+	return before
+}
+
+func rootOf(node *html.Node) *html.Node {
+	for node.Parent != nil {
+		node = node.Parent
+	}
+	return node
+}
+
+func cloneNode(node *html.Node) *html.Node {
+	clone := &html.Node{
+		Type:      node.Type,
+		DataAtom:  node.DataAtom,
+		Data:      node.Data,
+		Namespace: node.Namespace,
+		Attr:      append([]html.Attribute(nil), node.Attr...),
+	}
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		clone.AppendChild(cloneNode(child))
+	}
+	return clone
+}
@@ -0,0 +1,106 @@
+package page_test
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/renatoaraujo/go-zenrows/page"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files of the tests")
+
+// TestArticleGolden extracts the article of each page of testdata/readability and compares it to its golden file.
+// Run the tests with -update to regenerate the golden files after a deliberate change of the extractor.
+func TestArticleGolden(t *testing.T) {
+	pages, err := filepath.Glob(filepath.Join("testdata", "readability", "*.html"))
+	require.NoError(t, err)
+	require.NotEmpty(t, pages)
+
+	for _, path := range pages {
+		name := strings.TrimSuffix(filepath.Base(path), ".html")
+		t.Run(name, func(t *testing.T) {
+			body, err := os.ReadFile(path)
+			require.NoError(t, err)
+
+			doc, err := page.Parse(string(body), "https://example.test/"+name)
+			require.NoError(t, err)
+			article, err := doc.Article()
+			require.NoError(t, err)
+
+			actual, err := json.MarshalIndent(article, "", "  ")
+			require.NoError(t, err)
+			actual = append(actual, '\n')
+
+			golden := strings.TrimSuffix(path, ".html") + ".golden.json"
+			if *update {
+				require.NoError(t, os.WriteFile(golden, actual, 0o644))
+			}
+			expected, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), string(actual))
+		})
+	}
+}
+
+func TestArticleNoContent(t *testing.T) {
+	doc, err := page.Parse(`<nav><a href="/">Home</a></nav><p>Short.</p>`, "https://example.test/")
+	require.NoError(t, err)
+
+	_, err = doc.Article()
+	assert.ErrorIs(t, err, page.ErrNoArticle)
+}
+
+func TestArticlePublished(t *testing.T) {
+	body := `<article>` + strings.Repeat(`<p>The launch went well, with most of the planned features shipped on time, `+
+		`and the team is already working on the next release, which focuses on performance.</p>`, 3) + `</article>`
+	tests := []struct {
+		name     string
+		head     string
+		expected string
+	}{
+		{
+			"JSON-LD date with offset and fractional seconds",
+			`<script type="application/ld+json">{"@type": "NewsArticle", "datePublished": "2024-03-12T09:30:00.123+05:30"}</script>`,
+			"2024-03-12T09:30:00.123+05:30",
+		},
+		{
+			"Meta date with offset without colon",
+			`<meta property="article:published_time" content="2024-03-12T09:30:00-0500">`,
+			"2024-03-12T09:30:00-05:00",
+		},
+		{
+			"Meta date without seconds",
+			`<meta name="date" content="2024-03-12T09:30+01:00">`,
+			"2024-03-12T09:30:00+01:00",
+		},
+		{
+			"No date",
+			``,
+			"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := page.Parse(`<html><head>`+tt.head+`</head><body>`+body+`</body></html>`, "https://example.test/")
+			require.NoError(t, err)
+			article, err := doc.Article()
+			require.NoError(t, err)
+
+			if tt.expected == "" {
+				assert.Nil(t, article.Published)
+				return
+			}
+			require.NotNil(t, article.Published)
+			assert.Equal(t, tt.expected, article.Published.Format(time.RFC3339Nano))
+		})
+	}
+}
@@ -0,0 +1,458 @@
+package page
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// articleTypes are Article and its subtypes. Other CreativeWorks, e.g. JobPosting, are not articles.
+var articleTypes = map[string]bool{
+	"Article": true, "AdvertiserContentArticle": true, "SatiricalArticle": true,
+	"NewsArticle": true, "AnalysisNewsArticle": true, "AskPublicNewsArticle": true, "BackgroundNewsArticle": true,
+	"OpinionNewsArticle": true, "ReportageNewsArticle": true, "ReviewNewsArticle": true,
+	"SocialMediaPosting": true, "BlogPosting": true, "LiveBlogPosting": true, "DiscussionForumPosting": true,
+	"Report": true, "ScholarlyArticle": true, "MedicalScholarlyArticle": true, "TechArticle": true, "APIReference": true,
+}
+
+// Schema is implemented by the typed schema.org structures, e.g. *SchemaProduct, see As and Decode.
+type Schema interface {
+	// schemaType reports whether the structure decodes the given schema.org type name, e.g. "NewsArticle".
+	schemaType(name string) bool
+	decode(n schemaNode)
+}
+
+// SchemaThing are the properties shared by every schema.org type.
+type SchemaThing struct {
+	// Type are the types of the object, as found in the page.
+	Type        []string `json:"type,omitempty"`
+	ID          string   `json:"id,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Images      []string `json:"images,omitempty"`
+	SameAs      []string `json:"same_as,omitempty"`
+}
+
+// SchemaProduct is a schema.org Product.
+type SchemaProduct struct {
+	SchemaThing
+	SKU             string        `json:"sku,omitempty"`
+	GTIN            string        `json:"gtin,omitempty"`
+	MPN             string        `json:"mpn,omitempty"`
+	Brand           string        `json:"brand,omitempty"`
+	Category        string        `json:"category,omitempty"`
+	Offers          []SchemaOffer `json:"offers,omitempty"`
+	AggregateRating *SchemaRating `json:"aggregate_rating,omitempty"`
+}
+
+// SchemaOffer is a schema.org Offer or AggregateOffer.
+type SchemaOffer struct {
+	URL           string  `json:"url,omitempty"`
+	Price         float64 `json:"price,omitempty"`
+	LowPrice      float64 `json:"low_price,omitempty"`
+	HighPrice     float64 `json:"high_price,omitempty"`
+	OfferCount    int     `json:"offer_count,omitempty"`
+	PriceCurrency string  `json:"price_currency,omitempty"`
+	// Availability is the name of the ItemAvailability, e.g. InStock.
+	Availability string `json:"availability,omitempty"`
+	// ItemCondition is the name of the OfferItemCondition, e.g. NewCondition.
+	ItemCondition   string     `json:"item_condition,omitempty"`
+	PriceValidUntil *time.Time `json:"price_valid_until,omitempty"`
+	Seller          string     `json:"seller,omitempty"`
+}
+
+// SchemaRating is a schema.org AggregateRating or Rating.
+type SchemaRating struct {
+	RatingValue float64 `json:"rating_value,omitempty"`
+	BestRating  float64 `json:"best_rating,omitempty"`
+	WorstRating float64 `json:"worst_rating,omitempty"`
+	RatingCount int     `json:"rating_count,omitempty"`
+	ReviewCount int     `json:"review_count,omitempty"`
+}
+
+// SchemaArticle is a schema.org Article or one of its subtypes, e.g. NewsArticle or BlogPosting.
+type SchemaArticle struct {
+	SchemaThing
+	Headline       string              `json:"headline,omitempty"`
+	Authors        []SchemaThing       `json:"authors,omitempty"`
+	Publisher      *SchemaOrganization `json:"publisher,omitempty"`
+	ArticleSection string              `json:"article_section,omitempty"`
+	Keywords       []string            `json:"keywords,omitempty"`
+	DatePublished  *time.Time          `json:"date_published,omitempty"`
+	DateCreated    *time.Time          `json:"date_created,omitempty"`
+	DateModified   *time.Time          `json:"date_modified,omitempty"`
+}
+
+// SchemaOrganization is a schema.org Organization or one of its common subtypes, e.g. Corporation.
+type SchemaOrganization struct {
+	SchemaThing
+	LegalName string `json:"legal_name,omitempty"`
+	Logo      string `json:"logo,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Telephone string `json:"telephone,omitempty"`
+}
+
+// SchemaBreadcrumbList is a schema.org BreadcrumbList.
+type SchemaBreadcrumbList struct {
+	SchemaThing
+	// Items are the breadcrumbs, ordered by position.
+	Items []SchemaListItem `json:"items,omitempty"`
+}
+
+// SchemaListItem is a breadcrumb of a SchemaBreadcrumbList.
+type SchemaListItem struct {
+	Position int    `json:"position,omitempty"`
+	Name     string `json:"name,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+// As decodes the objects of the structured data having the schema.org type of T, e.g. As[SchemaProduct](data).
+// The JSON-LD objects come first, then the microdata and RDFa items. Properties the typed structures don't
+// cover are still available from the raw JSONLD, Microdata and RDFa values.
+func As[T any, PT interface {
+	*T
+	Schema
+}](data *StructuredData) []T {
+	var values []T
+	for _, n := range data.schemaNodes() {
+		var value T
+		target := PT(&value)
+		for _, typ := range n.types {
+			if target.schemaType(typeName(typ)) {
+				target.decode(n)
+				values = append(values, value)
+				break
+			}
+		}
+	}
+	return values
+}
+
+// Decode decodes a JSON-LD object or a microdata or RDFa *Item into target, whatever its type.
+// It reports false when the value is neither.
+func Decode(value any, target Schema) bool {
+	n, ok := newSchemaNode(value)
+	if ok {
+		target.decode(n)
+	}
+	return ok
+}
+
+func (s *StructuredData) schemaNodes() []schemaNode {
+	var nodes []schemaNode
+	for _, object := range s.JSONLD {
+		n, _ := newSchemaNode(object)
+		nodes = append(nodes, n)
+	}
+	for _, items := range [][]*Item{s.Microdata, s.RDFa} {
+		for _, item := range items {
+			if n, ok := newSchemaNode(item); ok {
+				nodes = append(nodes, n)
+			}
+		}
+	}
+	return nodes
+}
+
+func (t *SchemaThing) decode(n schemaNode) {
+	*t = SchemaThing{
+		Type:        n.types,
+		ID:          n.id,
+		Name:        n.text("name"),
+		Description: n.text("description"),
+		URL:         n.url("url"),
+		Images:      n.urls("image"),
+		SameAs:      n.urls("sameAs"),
+	}
+}
+
+func (p *SchemaProduct) schemaType(name string) bool {
+	return name == "Product" || name == "ProductGroup" || name == "ProductModel" || name == "IndividualProduct"
+}
+
+func (p *SchemaProduct) decode(n schemaNode) {
+	p.SchemaThing.decode(n)
+	p.SKU = n.text("sku")
+	p.GTIN = firstNonEmpty(n.text("gtin"), n.text("gtin13"), n.text("gtin12"), n.text("gtin14"), n.text("gtin8"))
+	p.MPN = n.text("mpn")
+	p.Brand = n.text("brand")
+	p.Category = n.text("category")
+	p.Offers = nil
+	for _, offer := range n.nodes("offers") {
+		var o SchemaOffer
+		o.decode(offer)
+		p.Offers = append(p.Offers, o)
+	}
+	p.AggregateRating = nil
+	if rating := n.nodes("aggregateRating"); len(rating) > 0 {
+		p.AggregateRating = &SchemaRating{}
+		p.AggregateRating.decode(rating[0])
+	}
+}
+
+func (o *SchemaOffer) schemaType(name string) bool {
+	return name == "Offer" || name == "AggregateOffer"
+}
+
+func (o *SchemaOffer) decode(n schemaNode) {
+	*o = SchemaOffer{
+		URL:             n.url("url"),
+		Price:           n.float("price"),
+		LowPrice:        n.float("lowPrice"),
+		HighPrice:       n.float("highPrice"),
+		OfferCount:      n.int("offerCount"),
+		PriceCurrency:   n.text("priceCurrency"),
+		Availability:    typeName(n.text("availability")),
+		ItemCondition:   typeName(n.text("itemCondition")),
+		PriceValidUntil: n.time("priceValidUntil"),
+		Seller:          n.text("seller"),
+	}
+}
+
+func (r *SchemaRating) schemaType(name string) bool {
+	return name == "AggregateRating" || name == "Rating"
+}
+
+func (r *SchemaRating) decode(n schemaNode) {
+	*r = SchemaRating{
+		RatingValue: n.float("ratingValue"),
+		BestRating:  n.float("bestRating"),
+		WorstRating: n.float("worstRating"),
+		RatingCount: n.int("ratingCount"),
+		ReviewCount: n.int("reviewCount"),
+	}
+}
+
+func (a *SchemaArticle) schemaType(name string) bool {
+	return articleTypes[name]
+}
+
+func (a *SchemaArticle) decode(n schemaNode) {
+	a.SchemaThing.decode(n)
+	a.Headline = n.text("headline")
+	a.Authors = nil
+	for _, value := range n.values("author") {
+		var author SchemaThing
+		if child, ok := newSchemaNode(value); ok {
+			author.decode(child)
+		} else {
+			author.Name = schemaText(value)
+		}
+		if author.Name != "" || author.URL != "" {
+			a.Authors = append(a.Authors, author)
+		}
+	}
+	a.Publisher = nil
+	if publisher := n.nodes("publisher"); len(publisher) > 0 {
+		a.Publisher = &SchemaOrganization{}
+		a.Publisher.decode(publisher[0])
+	} else if name := n.text("publisher"); name != "" {
+		a.Publisher = &SchemaOrganization{SchemaThing: SchemaThing{Name: name}}
+	}
+	a.ArticleSection = n.text("articleSection")
+	a.Keywords = nil
+	for _, keywords := range n.texts("keywords") {
+		for _, keyword := range strings.Split(keywords, ",") {
+			if keyword = strings.TrimSpace(keyword); keyword != "" {
+				a.Keywords = append(a.Keywords, keyword)
+			}
+		}
+	}
+	a.DatePublished = n.time("datePublished")
+	a.DateCreated = n.time("dateCreated")
+	a.DateModified = n.time("dateModified")
+}
+
+func (o *SchemaOrganization) schemaType(name string) bool {
+	switch name {
+	case "Organization", "Corporation", "NewsMediaOrganization", "OnlineStore", "LocalBusiness", "Store",
+		"EducationalOrganization", "NGO":
+		return true
+	}
+	return false
+}
+
+func (o *SchemaOrganization) decode(n schemaNode) {
+	o.SchemaThing.decode(n)
+	o.LegalName = n.text("legalName")
+	o.Logo = n.url("logo")
+	o.Email = n.text("email")
+	o.Telephone = n.text("telephone")
+}
+
+func (b *SchemaBreadcrumbList) schemaType(name string) bool {
+	return name == "BreadcrumbList"
+}
+
+func (b *SchemaBreadcrumbList) decode(n schemaNode) {
+	b.SchemaThing.decode(n)
+	b.Items = nil
+	for _, element := range n.nodes("itemListElement") {
+		item := SchemaListItem{
+			Position: element.int("position"),
+			Name:     element.text("name"),
+			URL:      firstNonEmpty(element.url("item"), element.url("url")),
+		}
+		if item.Name == "" {
+			if target := element.nodes("item"); len(target) > 0 {
+				item.Name = target[0].text("name")
+			}
+		}
+		b.Items = append(b.Items, item)
+	}
+	sort.SliceStable(b.Items, func(i, j int) bool { return b.Items[i].Position < b.Items[j].Position })
+}
+
+// schemaNode is a JSON-LD object or a microdata or RDFa item, as read by the typed decoders.
+type schemaNode struct {
+	types  []string
+	id     string
+	values func(name string) []any
+}
+
+func newSchemaNode(value any) (schemaNode, bool) {
+	switch v := value.(type) {
+	case map[string]any:
+		return schemaNode{
+			types: stringValues(v["@type"]),
+			id:    schemaText(v["@id"]),
+			values: func(name string) []any {
+				if values, ok := v[name].([]any); ok {
+					return values
+				}
+				if v[name] == nil {
+					return nil
+				}
+				return []any{v[name]}
+			},
+		}, true
+	case *Item:
+		if v == nil {
+			return schemaNode{}, false
+		}
+		return schemaNode{
+			types:  v.Type,
+			id:     v.ID,
+			values: func(name string) []any { return v.Properties[name] },
+		}, true
+	}
+	return schemaNode{}, false
+}
+
+// text returns the first non-empty text of a property.
+func (n schemaNode) text(name string) string {
+	for _, value := range n.values(name) {
+		if text := schemaText(value); text != "" {
+			return text
+		}
+	}
+	return ""
+}
+
+func (n schemaNode) texts(name string) []string {
+	var texts []string
+	for _, value := range n.values(name) {
+		if text := schemaText(value); text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return texts
+}
+
+func (n schemaNode) nodes(name string) []schemaNode {
+	var nodes []schemaNode
+	for _, value := range n.values(name) {
+		if child, ok := newSchemaNode(value); ok {
+			nodes = append(nodes, child)
+		}
+	}
+	return nodes
+}
+
+// urls returns the URLs of a property, given as strings or objects such as ImageObjects.
+func (n schemaNode) urls(name string) []string {
+	var urls []string
+	for _, value := range n.values(name) {
+		url := schemaText(value)
+		if child, ok := newSchemaNode(value); ok {
+			url = firstNonEmpty(child.text("url"), child.text("contentUrl"), child.id)
+		}
+		if url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
+func (n schemaNode) url(name string) string {
+	if urls := n.urls(name); len(urls) > 0 {
+		return urls[0]
+	}
+	return ""
+}
+
+func (n schemaNode) float(name string) float64 {
+	return parseNumber(n.text(name))
+}
+
+func (n schemaNode) int(name string) int {
+	return int(n.float(name))
+}
+
+func (n schemaNode) time(name string) *time.Time {
+	if t, ok := parseDate(n.text(name)); ok {
+		return &t
+	}
+	return nil
+}
+
+// parseNumber parses a number written with either separator convention, e.g. "1,299.00" or "1.299,00".
+// The last separator is the decimal point when both are used; a lone comma is one only when followed by
+// one or two digits, e.g. "12,99", and a thousands separator otherwise, e.g. "1,299".
+func parseNumber(text string) float64 {
+	text = strings.NewReplacer(" ", "", "\u00a0", "", "'", "").Replace(text)
+	dot, comma := strings.LastIndex(text, "."), strings.LastIndex(text, ",")
+	switch {
+	case dot >= 0 && comma > dot:
+		text = strings.ReplaceAll(text, ".", "")
+		text = strings.Replace(text, ",", ".", 1)
+	case comma >= 0 && dot < 0 && strings.Count(text, ",") == 1 && len(text)-comma-1 <= 2:
+		text = strings.Replace(text, ",", ".", 1)
+	default:
+		text = strings.ReplaceAll(text, ",", "")
+	}
+	f, _ := strconv.ParseFloat(text, 64)
+	return f
+}
+
+// schemaText returns the text of a value: strings and numbers as is, the name of nested objects.
+func schemaText(value any) string {
+	switch v := value.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	if n, ok := newSchemaNode(value); ok {
+		return firstNonEmpty(n.text("name"), n.text("@value"))
+	}
+	return ""
+}
+
+// parseDate parses a date with the first of the dateLayouts matching it.
+func parseDate(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,306 @@
+package page
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/cascadia"
+)
+
+var (
+	jsonLDSelector = cascadia.MustCompile(`script[type="application/ld+json" i]`)
+	metaSelector   = cascadia.MustCompile("meta[content]")
+)
+
+// openGraphPrefixes are the prefixes of the OpenGraph properties, including the ones of the object types.
+var openGraphPrefixes = []string{"og:", "article:", "book:", "profile:", "product:", "music:", "video:"}
+
+// StructuredData is the metadata a page exposes for search engines and social networks.
+type StructuredData struct {
+	// JSONLD are the JSON-LD objects of the page, the items of @graph objects are listed separately.
+	JSONLD []map[string]any `json:"json_ld,omitempty"`
+	// Microdata are the top-level microdata items of the page.
+	Microdata []*Item `json:"microdata,omitempty"`
+	// RDFa are the top-level RDFa Lite items of the page.
+	RDFa []*Item `json:"rdfa,omitempty"`
+	// OpenGraph are the OpenGraph properties of the page.
+	OpenGraph OpenGraph `json:"open_graph"`
+	// TwitterCard are the Twitter card properties of the page.
+	TwitterCard TwitterCard `json:"twitter_card"`
+	// Errors are the errors of the JSON-LD blocks that couldn't be decoded, the other blocks are still extracted.
+	Errors []error `json:"-"`
+}
+
+// Item is a microdata or RDFa item.
+type Item struct {
+	// Type are the types of the item, e.g. https://schema.org/Product.
+	Type []string `json:"type,omitempty"`
+	// ID is the global identifier of the item, its itemid or resource attribute.
+	ID string `json:"id,omitempty"`
+	// Properties are the values of each property of the item, either a string or an *Item.
+	Properties map[string][]any `json:"properties"`
+}
+
+// Value returns the first string value of a property of the item.
+func (i *Item) Value(name string) string {
+	for _, value := range i.Properties[name] {
+		if s, ok := value.(string); ok {
+			return s
+		}
+	}
+	return ""
+}
+
+// Item returns the first item value of a property of the item, nil if there's none.
+func (i *Item) Item(name string) *Item {
+	for _, value := range i.Properties[name] {
+		if item, ok := value.(*Item); ok {
+			return item
+		}
+	}
+	return nil
+}
+
+// IsA reports whether the item has the given type, either its full URL or its name, e.g. "Product".
+func (i *Item) IsA(typ string) bool {
+	for _, t := range i.Type {
+		if t == typ || typeName(t) == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// OpenGraph are the OpenGraph properties of a page.
+type OpenGraph struct {
+	Title       string           `json:"title,omitempty"`
+	Type        string           `json:"type,omitempty"`
+	URL         string           `json:"url,omitempty"`
+	Description string           `json:"description,omitempty"`
+	SiteName    string           `json:"site_name,omitempty"`
+	Locale      string           `json:"locale,omitempty"`
+	Images      []OpenGraphImage `json:"images,omitempty"`
+	// Properties are all the OpenGraph properties of the page by name, including the ones of the object type,
+	// such as article:published_time.
+	Properties map[string][]string `json:"properties,omitempty"`
+}
+
+// OpenGraphImage is an og:image with its structured properties.
+type OpenGraphImage struct {
+	URL       string `json:"url"`
+	SecureURL string `json:"secure_url,omitempty"`
+	Type      string `json:"type,omitempty"`
+	Width     int    `json:"width,omitempty"`
+	Height    int    `json:"height,omitempty"`
+	Alt       string `json:"alt,omitempty"`
+}
+
+// TwitterCard are the Twitter card properties of a page.
+type TwitterCard struct {
+	Card        string `json:"card,omitempty"`
+	Site        string `json:"site,omitempty"`
+	Creator     string `json:"creator,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Image       string `json:"image,omitempty"`
+	ImageAlt    string `json:"image_alt,omitempty"`
+	// Properties are all the twitter: properties of the page by name, without the prefix.
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// StructuredData extracts the JSON-LD, microdata, RDFa Lite, OpenGraph and Twitter card metadata of the page.
+func (d *Document) StructuredData() *StructuredData {
+	data := &StructuredData{
+		Microdata: d.microdata(),
+		RDFa:      d.rdfa(),
+		OpenGraph: d.openGraph(),
+	}
+	data.JSONLD, data.Errors = d.jsonLD()
+	data.TwitterCard = d.twitterCard()
+	return data
+}
+
+// JSONLDByType returns the JSON-LD objects with the given @type, either its full URL or its name, e.g. "Product".
+func (s *StructuredData) JSONLDByType(typ string) []map[string]any {
+	var objects []map[string]any
+	for _, object := range s.JSONLD {
+		for _, t := range stringValues(object["@type"]) {
+			if t == typ || typeName(t) == typ {
+				objects = append(objects, object)
+				break
+			}
+		}
+	}
+	return objects
+}
+
+func (d *Document) jsonLD() ([]map[string]any, []error) {
+	var objects []map[string]any
+	var errs []error
+	for i, script := range d.findAll(jsonLDSelector) {
+		text := strings.TrimSpace(textContent(script.Node))
+		text = strings.TrimSuffix(strings.TrimPrefix(text, "<!--"), "-->")
+		text = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(text), "//<![CDATA["), "//]]>")
+
+		var value any
+		if err := json.Unmarshal([]byte(text), &value); err != nil {
+			errs = append(errs, fmt.Errorf("failed to decode json-ld block %d: %w", i, err))
+			continue
+		}
+		objects = appendJSONLD(objects, value)
+	}
+	return objects, errs
+}
+
+func appendJSONLD(objects []map[string]any, value any) []map[string]any {
+	switch v := value.(type) {
+	case []any:
+		for _, item := range v {
+			objects = appendJSONLD(objects, item)
+		}
+	case map[string]any:
+		if graph, ok := v["@graph"]; ok {
+			return appendJSONLD(objects, graph)
+		}
+		objects = append(objects, v)
+	}
+	return objects
+}
+
+func (d *Document) openGraph() OpenGraph {
+	var og OpenGraph
+	for _, meta := range d.findAll(metaSelector) {
+		name := metaName(meta, "property", "name")
+		if !hasAnyPrefix(name, openGraphPrefixes) {
+			continue
+		}
+		content, _ := meta.Attr("content")
+		content = strings.TrimSpace(content)
+		if og.Properties == nil {
+			og.Properties = map[string][]string{}
+		}
+		og.Properties[name] = append(og.Properties[name], content)
+
+		switch name {
+		case "og:title":
+			og.Title = content
+		case "og:type":
+			og.Type = content
+		case "og:url":
+			og.URL = content
+		case "og:description":
+			og.Description = content
+		case "og:site_name":
+			og.SiteName = content
+		case "og:locale":
+			og.Locale = content
+		case "og:image", "og:image:url":
+			if name == "og:image:url" && len(og.Images) > 0 && og.Images[len(og.Images)-1].URL == "" {
+				og.Images[len(og.Images)-1].URL = content
+				continue
+			}
+			og.Images = append(og.Images, OpenGraphImage{URL: content})
+		case "og:image:secure_url", "og:image:type", "og:image:width", "og:image:height", "og:image:alt":
+			if len(og.Images) == 0 {
+				og.Images = append(og.Images, OpenGraphImage{})
+			}
+			setImageProperty(&og.Images[len(og.Images)-1], strings.TrimPrefix(name, "og:image:"), content)
+		}
+	}
+	return og
+}
+
+func setImageProperty(image *OpenGraphImage, name, content string) {
+	switch name {
+	case "secure_url":
+		image.SecureURL = content
+	case "type":
+		image.Type = content
+	case "width":
+		image.Width, _ = strconv.Atoi(content)
+	case "height":
+		image.Height, _ = strconv.Atoi(content)
+	case "alt":
+		image.Alt = content
+	}
+}
+
+func (d *Document) twitterCard() TwitterCard {
+	var card TwitterCard
+	for _, meta := range d.findAll(metaSelector) {
+		name := metaName(meta, "name", "property")
+		if !strings.HasPrefix(name, "twitter:") {
+			continue
+		}
+		name = strings.TrimPrefix(name, "twitter:")
+		content, _ := meta.Attr("content")
+		content = strings.TrimSpace(content)
+		if card.Properties == nil {
+			card.Properties = map[string]string{}
+		}
+		card.Properties[name] = content
+
+		switch name {
+		case "card":
+			card.Card = content
+		case "site":
+			card.Site = content
+		case "creator":
+			card.Creator = content
+		case "title":
+			card.Title = content
+		case "description":
+			card.Description = content
+		case "image", "image:src":
+			card.Image = content
+		case "image:alt":
+			card.ImageAlt = content
+		}
+	}
+	return card
+}
+
+// metaName returns the lowercase name of a meta element, from the first of the given attributes it has.
+func metaName(meta Element, attrs ...string) string {
+	for _, name := range attrs {
+		if value, ok := meta.Attr(name); ok && value != "" {
+			return strings.ToLower(strings.TrimSpace(value))
+		}
+	}
+	return ""
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// typeName returns the name of a type URL, e.g. Product for https://schema.org/Product.
+func typeName(typ string) string {
+	if i := strings.LastIndexAny(typ, "/#:"); i >= 0 {
+		return typ[i+1:]
+	}
+	return typ
+}
+
+func stringValues(value any) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var values []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
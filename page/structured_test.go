@@ -0,0 +1,290 @@
+package page_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/renatoaraujo/go-zenrows/page"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const productPage = `<!DOCTYPE html>
+<html>
+<head>
+	<meta property="og:title" content="Red shoes">
+	<meta property="og:type" content="product">
+	<meta property="og:url" content="https://shop.test/p/red-shoes">
+	<meta property="og:site_name" content="Shop">
+	<meta property="og:image" content="https://shop.test/img/red.png">
+	<meta property="og:image:width" content="800">
+	<meta property="og:image:height" content="600">
+	<meta property="og:image:alt" content="A red shoe">
+	<meta property="og:image" content="https://shop.test/img/red-side.png">
+	<meta property="product:price:amount" content="50.00">
+	<meta property="product:price:currency" content="USD">
+	<meta name="twitter:card" content="summary_large_image">
+	<meta name="twitter:site" content="@shop">
+	<meta name="twitter:title" content="Red shoes">
+	<meta name="twitter:image" content="https://shop.test/img/red.png">
+	<meta name="description" content="Not OpenGraph">
+	<script type="application/ld+json">
+	{
+		"@context": "https://schema.org",
+		"@graph": [
+			{"@type": "Product", "name": "Red shoes", "sku": "RS-1", "brand": {"@type": "Brand", "name": "Acme"},
+				"offers": {"@type": "Offer", "price": "50.00", "priceCurrency": "USD", "availability": "https://schema.org/InStock"}},
+			{"@type": ["BreadcrumbList"], "itemListElement": [
+				{"@type": "ListItem", "position": 2, "item": {"@id": "https://shop.test/shoes", "name": "Shoes"}},
+				{"@type": "ListItem", "position": 1, "name": "Home", "item": "https://shop.test/"}
+			]}
+		]
+	}
+	</script>
+	<script type="application/ld+json">[{"@type": "Organization", "name": "Shop", "logo": {"@type": "ImageObject", "url": "https://shop.test/logo.png"}}]</script>
+	<script type="application/ld+json">{"@type": "Broken",</script>
+</head>
+<body>
+	<div itemscope itemtype="https://schema.org/Product" itemid="/p/red-shoes" itemref="reviews">
+		<h1 itemprop="name">Red <b>shoes</b></h1>
+		<img itemprop="image" src="/img/red.png">
+		<a itemprop="url" href="/p/red-shoes">link</a>
+		<div itemprop="offers" itemscope itemtype="https://schema.org/Offer">
+			<meta itemprop="priceCurrency" content="USD">
+			<data itemprop="price" value="50.00">$50</data>
+			<time itemprop="priceValidUntil" datetime="2030-01-01">Jan 1</time>
+		</div>
+	</div>
+	<div id="reviews">
+		<span itemprop="aggregateRating" itemscope itemtype="https://schema.org/AggregateRating">
+			<span itemprop="ratingValue">4.5</span>
+		</span>
+	</div>
+
+	<div vocab="https://schema.org/" typeof="Article" resource="#article">
+		<h2 property="headline">Choosing shoes</h2>
+		<span property="author" typeof="Person"><span property="name">Ann</span></span>
+		<a property="url" href="/blog/shoes">read</a>
+		<meta property="datePublished" content="2024-03-01">
+	</div>
+</body>
+</html>`
+
+func TestStructuredData(t *testing.T) {
+	doc, err := page.Parse(productPage, "https://shop.test/p/red-shoes?ref=home")
+	require.NoError(t, err)
+	data := doc.StructuredData()
+
+	t.Run("json-ld", func(t *testing.T) {
+		require.Len(t, data.JSONLD, 3)
+		products := data.JSONLDByType("Product")
+		require.Len(t, products, 1)
+		assert.Equal(t, "Red shoes", products[0]["name"])
+		assert.Len(t, data.JSONLDByType("https://schema.org/BreadcrumbList"), 0)
+		assert.Len(t, data.JSONLDByType("BreadcrumbList"), 1)
+		assert.Len(t, data.JSONLDByType("Organization"), 1)
+		require.Len(t, data.Errors, 1)
+		assert.Contains(t, data.Errors[0].Error(), "failed to decode json-ld block 2")
+	})
+
+	t.Run("microdata", func(t *testing.T) {
+		require.Len(t, data.Microdata, 1)
+		product := data.Microdata[0]
+		assert.True(t, product.IsA("Product"))
+		assert.True(t, product.IsA("https://schema.org/Product"))
+		assert.Equal(t, "https://shop.test/p/red-shoes", product.ID)
+		assert.Equal(t, "Red shoes", product.Value("name"))
+		assert.Equal(t, "https://shop.test/img/red.png", product.Value("image"))
+		assert.Equal(t, "https://shop.test/p/red-shoes", product.Value("url"))
+
+		offer := product.Item("offers")
+		require.NotNil(t, offer)
+		assert.True(t, offer.IsA("Offer"))
+		assert.Equal(t, "USD", offer.Value("priceCurrency"))
+		assert.Equal(t, "50.00", offer.Value("price"))
+		assert.Equal(t, "2030-01-01", offer.Value("priceValidUntil"))
+
+		rating := product.Item("aggregateRating")
+		require.NotNil(t, rating)
+		assert.Equal(t, "4.5", rating.Value("ratingValue"))
+		assert.Nil(t, product.Item("name"))
+		assert.Empty(t, product.Value("offers"))
+	})
+
+	t.Run("rdfa", func(t *testing.T) {
+		require.Len(t, data.RDFa, 1)
+		article := data.RDFa[0]
+		assert.Equal(t, []string{"https://schema.org/Article"}, article.Type)
+		assert.Equal(t, "https://shop.test/p/red-shoes?ref=home#article", article.ID)
+		assert.Equal(t, "Choosing shoes", article.Value("headline"))
+		assert.Equal(t, "https://shop.test/blog/shoes", article.Value("url"))
+		assert.Equal(t, "2024-03-01", article.Value("datePublished"))
+
+		author := article.Item("author")
+		require.NotNil(t, author)
+		assert.True(t, author.IsA("Person"))
+		assert.Equal(t, "Ann", author.Value("name"))
+	})
+
+	t.Run("opengraph", func(t *testing.T) {
+		og := data.OpenGraph
+		assert.Equal(t, "Red shoes", og.Title)
+		assert.Equal(t, "product", og.Type)
+		assert.Equal(t, "https://shop.test/p/red-shoes", og.URL)
+		assert.Equal(t, "Shop", og.SiteName)
+		assert.Equal(t, []page.OpenGraphImage{
+			{URL: "https://shop.test/img/red.png", Width: 800, Height: 600, Alt: "A red shoe"},
+			{URL: "https://shop.test/img/red-side.png"},
+		}, og.Images)
+		assert.Equal(t, []string{"50.00"}, og.Properties["product:price:amount"])
+		assert.NotContains(t, og.Properties, "description")
+	})
+
+	t.Run("twitter card", func(t *testing.T) {
+		card := data.TwitterCard
+		assert.Equal(t, "summary_large_image", card.Card)
+		assert.Equal(t, "@shop", card.Site)
+		assert.Equal(t, "Red shoes", card.Title)
+		assert.Equal(t, "https://shop.test/img/red.png", card.Image)
+		assert.Equal(t, "@shop", card.Properties["site"])
+	})
+}
+
+func TestStructuredDataEmpty(t *testing.T) {
+	doc, err := page.Parse("<p>Nothing here</p>", "https://shop.test/")
+	require.NoError(t, err)
+
+	data := doc.StructuredData()
+	assert.Empty(t, data.JSONLD)
+	assert.Empty(t, data.Microdata)
+	assert.Empty(t, data.RDFa)
+	assert.Empty(t, data.Errors)
+	assert.Equal(t, page.OpenGraph{}, data.OpenGraph)
+	assert.Equal(t, page.TwitterCard{}, data.TwitterCard)
+}
+
+func TestMicrodataItemRefCycle(t *testing.T) {
+	doc, err := page.Parse(`
+		<div id="a" itemscope itemtype="https://schema.org/Person" itemref="b">
+			<span itemprop="name">Ann</span>
+		</div>
+		<div id="b"><span itemprop="knows" itemscope itemref="a"><span itemprop="name">Bob</span></span></div>`,
+		"https://shop.test/")
+	require.NoError(t, err)
+
+	items := doc.StructuredData().Microdata
+	require.Len(t, items, 1)
+	assert.Equal(t, "Ann", items[0].Value("name"))
+	friend := items[0].Item("knows")
+	require.NotNil(t, friend)
+	assert.Equal(t, "Bob", friend.Value("name"))
+}
+
+func TestSchema(t *testing.T) {
+	doc, err := page.Parse(productPage, "https://shop.test/p/red-shoes?ref=home")
+	require.NoError(t, err)
+	data := doc.StructuredData()
+
+	t.Run("product", func(t *testing.T) {
+		products := page.As[page.SchemaProduct](data)
+		require.Len(t, products, 2)
+
+		fromJSONLD := products[0]
+		assert.Equal(t, "Red shoes", fromJSONLD.Name)
+		assert.Equal(t, "RS-1", fromJSONLD.SKU)
+		assert.Equal(t, "Acme", fromJSONLD.Brand)
+		assert.Equal(t, []page.SchemaOffer{{Price: 50, PriceCurrency: "USD", Availability: "InStock"}}, fromJSONLD.Offers)
+		assert.Nil(t, fromJSONLD.AggregateRating)
+
+		fromMicrodata := products[1]
+		assert.Equal(t, "https://shop.test/p/red-shoes", fromMicrodata.ID)
+		assert.Equal(t, []string{"https://shop.test/img/red.png"}, fromMicrodata.Images)
+		require.Len(t, fromMicrodata.Offers, 1)
+		assert.Equal(t, 50.0, fromMicrodata.Offers[0].Price)
+		require.NotNil(t, fromMicrodata.Offers[0].PriceValidUntil)
+		assert.Equal(t, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), *fromMicrodata.Offers[0].PriceValidUntil)
+		assert.Equal(t, &page.SchemaRating{RatingValue: 4.5}, fromMicrodata.AggregateRating)
+	})
+
+	t.Run("breadcrumbs", func(t *testing.T) {
+		lists := page.As[page.SchemaBreadcrumbList](data)
+		require.Len(t, lists, 1)
+		assert.Equal(t, []page.SchemaListItem{
+			{Position: 1, Name: "Home", URL: "https://shop.test/"},
+			{Position: 2, Name: "Shoes", URL: "https://shop.test/shoes"},
+		}, lists[0].Items)
+	})
+
+	t.Run("organization", func(t *testing.T) {
+		organizations := page.As[page.SchemaOrganization](data)
+		require.Len(t, organizations, 1)
+		assert.Equal(t, "Shop", organizations[0].Name)
+		assert.Equal(t, "https://shop.test/logo.png", organizations[0].Logo)
+	})
+
+	t.Run("article", func(t *testing.T) {
+		articles := page.As[page.SchemaArticle](data)
+		require.Len(t, articles, 1)
+		article := articles[0]
+		assert.Equal(t, "Choosing shoes", article.Headline)
+		assert.Equal(t, "https://shop.test/blog/shoes", article.URL)
+		require.Len(t, article.Authors, 1)
+		assert.Equal(t, "Ann", article.Authors[0].Name)
+		require.NotNil(t, article.DatePublished)
+		assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), *article.DatePublished)
+	})
+
+	t.Run("decode", func(t *testing.T) {
+		var article page.SchemaArticle
+		require.True(t, page.Decode(map[string]any{
+			"@type":    "NewsArticle",
+			"headline": "Launch",
+			"author":   []any{"Bob", map[string]any{"@type": "Person", "name": "Eve"}},
+			"keywords": "news, launch",
+		}, &article))
+		assert.Equal(t, "Launch", article.Headline)
+		assert.Equal(t, []page.SchemaThing{{Name: "Bob"}, {Type: []string{"Person"}, Name: "Eve"}}, article.Authors)
+		assert.Equal(t, []string{"news", "launch"}, article.Keywords)
+
+		assert.False(t, page.Decode("not an object", &article))
+	})
+}
+
+func TestSchemaOfferPrice(t *testing.T) {
+	tests := []struct {
+		price    any
+		expected float64
+	}{
+		{50.5, 50.5},
+		{"50.00", 50},
+		{"12,99", 12.99},
+		{"1.299,00", 1299},
+		{"1,299", 1299},
+		{"1,299.50", 1299.5},
+		{"1 299,5", 1299.5},
+		{"free", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.price), func(t *testing.T) {
+			var offer page.SchemaOffer
+			require.True(t, page.Decode(map[string]any{"@type": "Offer", "price": tt.price}, &offer))
+			assert.Equal(t, tt.expected, offer.Price)
+		})
+	}
+}
+
+func TestSchemaArticleTypes(t *testing.T) {
+	data := &page.StructuredData{JSONLD: []map[string]any{
+		{"@type": "JobPosting", "title": "Shoe designer"},
+		{"@type": "BlogPosting", "headline": "Choosing shoes"},
+		{"@type": "schema:NewsArticle", "headline": "Shoe sales are up"},
+	}}
+
+	articles := page.As[page.SchemaArticle](data)
+	require.Len(t, articles, 2)
+	assert.Equal(t, "Choosing shoes", articles[0].Headline)
+	assert.Equal(t, "Shoe sales are up", articles[1].Headline)
+}
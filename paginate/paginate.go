@@ -0,0 +1,209 @@
+// Package paginate iterates over the pages of a paginated website through ZenRows.
+//
+// A Paginator starts from a first URL and finds the following pages with a Strategy: a CSS selector
+// for the "next" link, a URL template with the page number, an offset parameter, or infinite scroll.
+// It stops after a maximum number of pages, when a page is a duplicate of a previous one, or when
+// a page is empty:
+//
+//	p := paginate.New(client, "https://example.com/products", paginate.NextLink("a.next")).
+//		WithMaxPages(20).
+//		WithItemSelector(".product")
+//	for {
+//		page, err := p.Next(ctx)
+//		if err == io.EOF {
+//			break
+//		}
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(page.Number, page.URL)
+//	}
+package paginate
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/url"
+	"sync"
+
+	"github.com/andybalholm/cascadia"
+
+	"github.com/renatoaraujo/go-zenrows"
+	"github.com/renatoaraujo/go-zenrows/page"
+)
+
+// DefaultMaxPages is the number of pages after which a Paginator stops unless configured otherwise.
+const DefaultMaxPages = 100
+
+// Executor makes the scrapes of a Paginator, it's implemented by zenrows.Client and zenrows.Session.
+type Executor interface {
+	Execute(ctx context.Context, call *zenrows.Call) (*zenrows.Response, error)
+}
+
+// Page is a scraped page.
+type Page struct {
+	// Number is the position of the page, starting at 1.
+	Number int
+	// URL is the URL the page was scraped from.
+	URL string
+	// Options are the scrape options specific to the page, set by the Strategy.
+	Options []zenrows.ScrapeOptions
+	// Response is the response of ZenRows.
+	Response *zenrows.Response
+
+	parseOnce sync.Once
+	doc       *page.Document
+	parseErr  error
+}
+
+// Document returns the parsed HTML of the page, it's parsed once.
+func (p *Page) Document() (*page.Document, error) {
+	p.parseOnce.Do(func() {
+		p.doc, p.parseErr = page.FromResponse(p.Response, p.URL)
+	})
+	return p.doc, p.parseErr
+}
+
+// Paginator iterates over the pages of a website.
+type Paginator struct {
+	executor     Executor
+	strategy     Strategy
+	options      []zenrows.ScrapeOptions
+	maxPages     int
+	empty        func(page *Page) (bool, error)
+	allowRepeats bool
+
+	next *Page
+	seen map[[sha256.Size]byte]struct{}
+	err  error
+}
+
+// New creates a Paginator scraping firstURL and the pages found by strategy with executor.
+func New(executor Executor, firstURL string, strategy Strategy) *Paginator {
+	return &Paginator{
+		executor: executor,
+		strategy: strategy,
+		maxPages: DefaultMaxPages,
+		next:     &Page{Number: 1, URL: firstURL, Options: strategy.First()},
+		seen:     map[[sha256.Size]byte]struct{}{},
+	}
+}
+
+// WithOptions Configures the scrape options of every page
+func (p *Paginator) WithOptions(params ...zenrows.ScrapeOptions) *Paginator {
+	p.options = append(p.options, params...)
+	return p
+}
+
+// WithMaxPages Configures the number of pages after which the Paginator stops, zero means no limit
+func (p *Paginator) WithMaxPages(n int) *Paginator {
+	p.maxPages = n
+	return p
+}
+
+// WithEmpty Configures how empty pages are detected; the Paginator stops at the first empty page, without returning it
+func (p *Paginator) WithEmpty(empty func(page *Page) (bool, error)) *Paginator {
+	p.empty = empty
+	return p
+}
+
+// WithItemSelector Makes pages with no element matching the CSS selector empty, see WithEmpty
+func (p *Paginator) WithItemSelector(selector string) *Paginator {
+	if _, err := cascadia.Parse(selector); err != nil {
+		p.err = fmt.Errorf("failed to compile item selector: %w", err)
+		return p
+	}
+	return p.WithEmpty(func(pg *Page) (bool, error) {
+		doc, err := pg.Document()
+		if err != nil {
+			return false, err
+		}
+		item, err := doc.First(selector)
+		return !item.Exists(), err
+	})
+}
+
+// WithDuplicates Configures whether pages with the same URL and options, or the same content, as a previous page
+// are returned; by default the Paginator stops at the first duplicate page, without returning it
+func (p *Paginator) WithDuplicates(allow bool) *Paginator {
+	p.allowRepeats = allow
+	return p
+}
+
+// Next scrapes and returns the next page, or io.EOF when there are no more pages.
+// Once Next returned an error, it keeps returning it.
+func (p *Paginator) Next(ctx context.Context) (*Page, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	page, err := p.fetch(ctx)
+	if err != nil {
+		p.err = err
+		return nil, err
+	}
+	return page, nil
+}
+
+func (p *Paginator) fetch(ctx context.Context) (*Page, error) {
+	page := p.next
+	if page == nil || (p.maxPages > 0 && page.Number > p.maxPages) {
+		return nil, io.EOF
+	}
+	if !p.allowRepeats && !p.firstTime("url", page.URL, encodeOptions(page.Options)) {
+		return nil, io.EOF
+	}
+
+	params := append(append([]zenrows.ScrapeOptions{}, p.options...), page.Options...)
+	resp, err := p.executor.Execute(ctx, &zenrows.Call{TargetURL: page.URL, Options: params})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scrape page %d: %w", page.Number, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("failed to scrape page %d: unexpected status code %d", page.Number, resp.StatusCode)
+	}
+	page.Response = resp
+
+	if !p.allowRepeats && !p.firstTime("body", resp.Body) {
+		return nil, io.EOF
+	}
+	if p.empty != nil {
+		empty, err := p.empty(page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check page %d: %w", page.Number, err)
+		}
+		if empty {
+			return nil, io.EOF
+		}
+	}
+
+	next, err := p.strategy.Next(page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find page %d: %w", page.Number+1, err)
+	}
+	p.next = nil
+	if next != nil {
+		next.Number = page.Number + 1
+		p.next = next
+	}
+	return page, nil
+}
+
+// firstTime records the digest of the given parts, reporting whether it wasn't seen before.
+func (p *Paginator) firstTime(parts ...any) bool {
+	sum := sha256.Sum256([]byte(fmt.Sprint(parts...)))
+	if _, ok := p.seen[sum]; ok {
+		return false
+	}
+	p.seen[sum] = struct{}{}
+	return true
+}
+
+func encodeOptions(params []zenrows.ScrapeOptions) string {
+	values := url.Values{}
+	for _, param := range params {
+		param(values)
+	}
+	return values.Encode()
+}
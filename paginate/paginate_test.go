@@ -0,0 +1,161 @@
+package paginate_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	"github.com/renatoaraujo/go-zenrows/paginate"
+	"github.com/renatoaraujo/go-zenrows/zenrowstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// executorFunc scrapes pages with a function, to tell pages apart by their options.
+type executorFunc func(call *zenrows.Call) (*zenrows.Response, error)
+
+func (f executorFunc) Execute(_ context.Context, call *zenrows.Call) (*zenrows.Response, error) {
+	return f(call)
+}
+
+func collect(t *testing.T, p *paginate.Paginator) ([]string, error) {
+	t.Helper()
+	var urls []string
+	for {
+		page, err := p.Next(context.Background())
+		if err == io.EOF {
+			return urls, nil
+		}
+		if err != nil {
+			return urls, err
+		}
+		urls = append(urls, page.URL)
+	}
+}
+
+func TestPaginatorStops(t *testing.T) {
+	srv := zenrowstest.NewServer("key")
+	defer srv.Close()
+	srv.Handle("https://shop.test/list?page=1", zenrowstest.Fixture{Body: `<li class="item">a</li>`})
+	srv.Handle("https://shop.test/list?page=2", zenrowstest.Fixture{Body: `<li class="item">b</li>`})
+	srv.Handle("https://shop.test/list?page=3", zenrowstest.Fixture{Body: `<li class="item">b</li>`})
+	srv.Handle("https://shop.test/list?page=4", zenrowstest.Fixture{Body: `<p>No more items</p>`})
+	srv.HandleDefault(zenrowstest.Fixture{Body: `<li class="item">c</li>`})
+	strategy := paginate.URLTemplate("https://shop.test/list?page={page}", 1)
+
+	tests := []struct {
+		name      string
+		paginator func() *paginate.Paginator
+		expected  []string
+	}{
+		{
+			"max pages",
+			func() *paginate.Paginator {
+				return paginate.New(srv.Client(), "https://shop.test/list?page=1", strategy).WithMaxPages(1)
+			},
+			[]string{"https://shop.test/list?page=1"},
+		},
+		{
+			"duplicate content",
+			func() *paginate.Paginator {
+				return paginate.New(srv.Client(), "https://shop.test/list?page=1", strategy)
+			},
+			[]string{"https://shop.test/list?page=1", "https://shop.test/list?page=2"},
+		},
+		{
+			"empty page",
+			func() *paginate.Paginator {
+				return paginate.New(srv.Client(), "https://shop.test/list?page=1", strategy).
+					WithDuplicates(true).
+					WithItemSelector(".item")
+			},
+			[]string{"https://shop.test/list?page=1", "https://shop.test/list?page=2", "https://shop.test/list?page=3"},
+		},
+		{
+			"custom empty",
+			func() *paginate.Paginator {
+				return paginate.New(srv.Client(), "https://shop.test/list?page=1", strategy).
+					WithEmpty(func(page *paginate.Page) (bool, error) { return page.Number == 2, nil })
+			},
+			[]string{"https://shop.test/list?page=1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			urls, err := collect(t, tt.paginator())
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, urls)
+		})
+	}
+}
+
+func TestPaginatorOptions(t *testing.T) {
+	srv := zenrowstest.NewServer("key")
+	defer srv.Close()
+	srv.HandleDefault(zenrowstest.Fixture{Body: "ok"})
+
+	p := paginate.New(srv.Client(), "https://shop.test/", paginate.NextLink("a.next")).
+		WithOptions(zenrows.WithPremiumProxy())
+	page, err := p.Next(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, page.Number)
+	assert.Equal(t, "ok", page.Response.Body)
+
+	_, err = p.Next(context.Background())
+	assert.Equal(t, io.EOF, err)
+	requests := srv.Requests()
+	require.Len(t, requests, 1)
+	assert.Equal(t, zenrows.Bool(true), requests[0].Options.PremiumProxy)
+}
+
+func TestPaginatorErrors(t *testing.T) {
+	failure := errors.New("connection reset")
+	tests := []struct {
+		name      string
+		paginator *paginate.Paginator
+		expected  string
+	}{
+		{
+			"scrape error",
+			paginate.New(executorFunc(func(*zenrows.Call) (*zenrows.Response, error) { return nil, failure }),
+				"https://shop.test/", paginate.NextLink("a")),
+			"failed to scrape page 1: connection reset",
+		},
+		{
+			"unexpected status",
+			paginate.New(executorFunc(func(*zenrows.Call) (*zenrows.Response, error) {
+				return &zenrows.Response{StatusCode: http.StatusNotFound}, nil
+			}), "https://shop.test/", paginate.NextLink("a")),
+			"failed to scrape page 1: unexpected status code 404",
+		},
+		{
+			"invalid item selector",
+			paginate.New(executorFunc(func(*zenrows.Call) (*zenrows.Response, error) { return nil, failure }),
+				"https://shop.test/", paginate.NextLink("a")).WithItemSelector("[["),
+			"failed to compile item selector",
+		},
+		{
+			"invalid next link selector",
+			paginate.New(executorFunc(func(*zenrows.Call) (*zenrows.Response, error) {
+				return &zenrows.Response{StatusCode: http.StatusOK}, nil
+			}), "https://shop.test/", paginate.NextLink("[[")),
+			"failed to find page 2: failed to compile next link selector",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.paginator.Next(context.Background())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expected)
+
+			_, again := tt.paginator.Next(context.Background())
+			assert.Equal(t, err, again)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package paginate
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/cascadia"
+
+	"github.com/renatoaraujo/go-zenrows"
+)
+
+// PageNumberPlaceholder is replaced by the page number in the templates of URLTemplate.
+const PageNumberPlaceholder = "{page}"
+
+// Strategy finds the pages following the first one.
+type Strategy interface {
+	// First returns the scrape options of the first page.
+	First() []zenrows.ScrapeOptions
+	// Next returns the page following page, with its URL and options set, or nil when it's the last one.
+	Next(page *Page) (*Page, error)
+}
+
+type nextLink struct {
+	selector string
+	err      error
+}
+
+// NextLink follows the href attribute of the first element matching the CSS selector, e.g. "a[rel=next]".
+// Pagination ends on the first page without such an element.
+func NextLink(selector string) Strategy {
+	_, err := cascadia.Parse(selector)
+	if err != nil {
+		err = fmt.Errorf("failed to compile next link selector: %w", err)
+	}
+	return &nextLink{selector: selector, err: err}
+}
+
+func (s *nextLink) First() []zenrows.ScrapeOptions {
+	return nil
+}
+
+func (s *nextLink) Next(page *Page) (*Page, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	doc, err := page.Document()
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := doc.First(s.selector)
+	if err != nil {
+		return nil, err
+	}
+	href, _ := link.Attr("href")
+	href = strings.TrimSpace(href)
+	if href == "" || strings.HasPrefix(href, "#") || strings.HasPrefix(strings.ToLower(href), "javascript:") {
+		return nil, nil
+	}
+
+	next, err := doc.Resolve(href)
+	if err != nil {
+		return nil, err
+	}
+	return &Page{URL: next}, nil
+}
+
+type urlTemplate struct {
+	template string
+	first    int
+}
+
+// URLTemplate builds the URL of each page by replacing PageNumberPlaceholder in template with its number, e.g.
+// "https://example.com/products?page={page}"; first is the number of the page the Paginator was created with,
+// usually 0 or 1.
+// Pagination ends with the stop conditions of the Paginator.
+func URLTemplate(template string, first int) Strategy {
+	return &urlTemplate{template: template, first: first}
+}
+
+func (s *urlTemplate) First() []zenrows.ScrapeOptions {
+	return nil
+}
+
+func (s *urlTemplate) Next(page *Page) (*Page, error) {
+	if !strings.Contains(s.template, PageNumberPlaceholder) {
+		return nil, fmt.Errorf("url template has no %s placeholder", PageNumberPlaceholder)
+	}
+	number := strconv.Itoa(s.first + page.Number)
+	return &Page{URL: strings.ReplaceAll(s.template, PageNumberPlaceholder, number)}, nil
+}
+
+type offset struct {
+	param string
+	step  int
+}
+
+// Offset increments the param query parameter of the page URL by step for each page, e.g.
+// "?start=0", "?start=20", "?start=40"; a missing parameter counts as 0.
+// Pagination ends with the stop conditions of the Paginator.
+func Offset(param string, step int) Strategy {
+	return &offset{param: param, step: step}
+}
+
+func (s *offset) First() []zenrows.ScrapeOptions {
+	return nil
+}
+
+func (s *offset) Next(page *Page) (*Page, error) {
+	if s.step <= 0 {
+		return nil, errors.New("offset step must be positive")
+	}
+	u, err := url.Parse(page.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse page url: %w", err)
+	}
+
+	query := u.Query()
+	current := 0
+	if value := query.Get(s.param); value != "" {
+		if current, err = strconv.Atoi(value); err != nil {
+			return nil, fmt.Errorf("failed to parse %s parameter: %w", s.param, err)
+		}
+	}
+	query.Set(s.param, strconv.Itoa(current+s.step))
+	u.RawQuery = query.Encode()
+	return &Page{URL: u.String()}, nil
+}
+
+type scrollBy struct {
+	distance int
+	wait     int
+}
+
+// ScrollBy paginates an infinite scroll: it scrapes the same URL with JavaScript rendering, scrolling down
+// by distance pixels and waiting wait milliseconds one more time for each page, so page n is the content
+// loaded after n-1 scrolls. Each page holds the whole content loaded so far; pagination ends when scrolling
+// loads nothing new, as the page is then a duplicate of the previous one.
+// To scroll a fixed number of times in a single scrape instead, use zenrows.WithScrollCapture.
+func ScrollBy(distance, wait int) Strategy {
+	return &scrollBy{distance: distance, wait: wait}
+}
+
+func (s *scrollBy) First() []zenrows.ScrapeOptions {
+	return []zenrows.ScrapeOptions{zenrows.WithJSRender()}
+}
+
+func (s *scrollBy) Next(page *Page) (*Page, error) {
+	instructions := make([]zenrows.Instruction, 0, 2*page.Number)
+	for i := 0; i < page.Number; i++ {
+		instructions = append(instructions, zenrows.ScrollY(s.distance), zenrows.Wait(s.wait))
+	}
+	return &Page{URL: page.URL, Options: []zenrows.ScrapeOptions{zenrows.WithInstructions(instructions...)}}, nil
+}
@@ -0,0 +1,98 @@
+package paginate_test
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	"github.com/renatoaraujo/go-zenrows/paginate"
+	"github.com/renatoaraujo/go-zenrows/zenrowstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextLink(t *testing.T) {
+	srv := zenrowstest.NewServer("key")
+	defer srv.Close()
+	srv.Handle("https://blog.test/", zenrowstest.Fixture{
+		Header: http.Header{"Zr-Final-Url": {"https://blog.test/posts/"}},
+		Body:   `<a href="/about">About</a><a rel="next" href="?page=2">Older</a>`,
+	})
+	srv.Handle("https://blog.test/posts/?page=2", zenrowstest.Fixture{
+		Body: `<a rel="next" href="https://blog.test/posts/?page=3">Older</a>`,
+	})
+	srv.Handle("https://blog.test/posts/?page=3", zenrowstest.Fixture{Body: `<a rel="next" href="#">Older</a>`})
+
+	urls, err := collect(t, paginate.New(srv.Client(), "https://blog.test/", paginate.NextLink("a[rel=next]")))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://blog.test/", "https://blog.test/posts/?page=2", "https://blog.test/posts/?page=3"}, urls)
+}
+
+func TestNextLinkLoop(t *testing.T) {
+	srv := zenrowstest.NewServer("key")
+	defer srv.Close()
+	srv.Handle("https://blog.test/a", zenrowstest.Fixture{Body: `<a class="next" href="/b">b</a>`})
+	srv.Handle("https://blog.test/b", zenrowstest.Fixture{Body: `<a class="next" href="/a">a</a>`})
+
+	urls, err := collect(t, paginate.New(srv.Client(), "https://blog.test/a", paginate.NextLink(".next")))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://blog.test/a", "https://blog.test/b"}, urls)
+}
+
+func TestOffset(t *testing.T) {
+	executor := executorFunc(func(call *zenrows.Call) (*zenrows.Response, error) {
+		return &zenrows.Response{StatusCode: http.StatusOK, Body: call.TargetURL}, nil
+	})
+
+	urls, err := collect(t, paginate.New(executor, "https://jobs.test/search?q=go", paginate.Offset("start", 20)).WithMaxPages(3))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"https://jobs.test/search?q=go",
+		"https://jobs.test/search?q=go&start=20",
+		"https://jobs.test/search?q=go&start=40",
+	}, urls)
+
+	_, err = collect(t, paginate.New(executor, "https://jobs.test/search?start=x", paginate.Offset("start", 20)))
+	assert.ErrorContains(t, err, "failed to parse start parameter")
+}
+
+func TestURLTemplate(t *testing.T) {
+	executor := executorFunc(func(call *zenrows.Call) (*zenrows.Response, error) {
+		return &zenrows.Response{StatusCode: http.StatusOK, Body: call.TargetURL}, nil
+	})
+
+	urls, err := collect(t, paginate.New(executor, "https://shop.test/p/0", paginate.URLTemplate("https://shop.test/p/{page}", 0)).WithMaxPages(3))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://shop.test/p/0", "https://shop.test/p/1", "https://shop.test/p/2"}, urls)
+
+	_, err = collect(t, paginate.New(executor, "https://shop.test/p/0", paginate.URLTemplate("https://shop.test/p", 0)))
+	assert.ErrorContains(t, err, "no {page} placeholder")
+}
+
+func TestScrollBy(t *testing.T) {
+	var instructions []string
+	executor := executorFunc(func(call *zenrows.Call) (*zenrows.Response, error) {
+		values := url.Values{}
+		for _, param := range call.Options {
+			param(values)
+		}
+		assert.Equal(t, "true", values.Get("js_render"))
+		instructions = append(instructions, values.Get("js_instructions"))
+
+		// The feed has two pages of content: scrolling more doesn't load anything new.
+		items := min(strings.Count(values.Get("js_instructions"), "scroll_y")+1, 2)
+		return &zenrows.Response{StatusCode: http.StatusOK, Body: strings.Repeat("<li>item</li>", items)}, nil
+	})
+
+	urls, err := collect(t, paginate.New(executor, "https://feed.test/", paginate.ScrollBy(1000, 500)))
+	require.NoError(t, err)
+	assert.Len(t, urls, 2)
+	assert.Equal(t, []string{
+		"",
+		`[{"scroll_y":1000},{"wait":500}]`,
+		`[{"scroll_y":1000},{"wait":500},{"scroll_y":1000},{"wait":500}]`,
+	}, instructions)
+}
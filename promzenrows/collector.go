@@ -0,0 +1,133 @@
+// Package promzenrows provides a Prometheus collector for the go-zenrows client.
+//
+// The Collector is both a prometheus.Collector and a zenrows.Observer, so it has to be registered
+// on the Prometheus registry and on the client:
+//
+//	collector := promzenrows.NewCollector()
+//	prometheus.MustRegister(collector)
+//	client := zenrows.NewClient(hc).WithApiKey("ZENROWS_API_KEY").WithObserver(collector)
+package promzenrows
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/renatoaraujo/go-zenrows"
+)
+
+const namespace = "zenrows"
+
+// Collector exposes Prometheus metrics about the scrapes made by a zenrows.Client.
+type Collector struct {
+	requests             *prometheus.CounterVec
+	duration             *prometheus.HistogramVec
+	bodySize             *prometheus.HistogramVec
+	credits              *prometheus.CounterVec
+	inFlight             prometheus.Gauge
+	concurrencyLimit     prometheus.Gauge
+	concurrencyRemaining prometheus.Gauge
+}
+
+var (
+	_ prometheus.Collector = (*Collector)(nil)
+	_ zenrows.Observer     = (*Collector)(nil)
+)
+
+// NewCollector creates a Collector.
+func NewCollector() *Collector {
+	return &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "Number of ZenRows scrapes by status, error class and target host.",
+		}, []string{"status", "error_class", "host"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of ZenRows scrapes.",
+			Buckets:   []float64{.25, .5, 1, 2.5, 5, 10, 20, 30, 60, 120},
+		}, []string{"host"}),
+		bodySize: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "response_size_bytes",
+			Help:      "Size of the content returned by ZenRows scrapes.",
+			Buckets:   prometheus.ExponentialBuckets(1024, 4, 8),
+		}, []string{"host"}),
+		credits: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "credits_total",
+			Help:      "ZenRows credits spent by target host.",
+		}, []string{"host"}),
+		inFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "requests_in_flight",
+			Help:      "Number of ZenRows scrapes in flight.",
+		}),
+		concurrencyLimit: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "concurrency_limit",
+			Help:      "Concurrency limit of the ZenRows plan, as reported by the last response.",
+		}),
+		concurrencyRemaining: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "concurrency_remaining",
+			Help:      "Remaining ZenRows concurrency, as reported by the last response.",
+		}),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+	c.duration.Describe(ch)
+	c.bodySize.Describe(ch)
+	c.credits.Describe(ch)
+	c.inFlight.Describe(ch)
+	c.concurrencyLimit.Describe(ch)
+	c.concurrencyRemaining.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+	c.duration.Collect(ch)
+	c.bodySize.Collect(ch)
+	c.credits.Collect(ch)
+	c.inFlight.Collect(ch)
+	c.concurrencyLimit.Collect(ch)
+	c.concurrencyRemaining.Collect(ch)
+}
+
+// ScrapeStarted implements zenrows.Observer.
+func (c *Collector) ScrapeStarted(ctx context.Context, _ zenrows.ScrapeInfo) context.Context {
+	c.inFlight.Inc()
+	return ctx
+}
+
+// ScrapeFinished implements zenrows.Observer.
+func (c *Collector) ScrapeFinished(_ context.Context, info zenrows.ScrapeInfo, result zenrows.ScrapeResult) {
+	c.inFlight.Dec()
+
+	status := ""
+	if result.StatusCode != 0 {
+		status = strconv.Itoa(result.StatusCode)
+	}
+	c.requests.WithLabelValues(status, string(result.ErrorClass), info.Host).Inc()
+	c.duration.WithLabelValues(info.Host).Observe(result.Duration.Seconds())
+
+	if result.StatusCode == 0 {
+		return
+	}
+	c.bodySize.WithLabelValues(info.Host).Observe(float64(result.BodySize))
+	if result.Cost >= 0 {
+		c.credits.WithLabelValues(info.Host).Add(result.Cost)
+	}
+	if result.ConcurrencyLimit >= 0 {
+		c.concurrencyLimit.Set(result.ConcurrencyLimit)
+	}
+	if result.ConcurrencyRemaining >= 0 {
+		c.concurrencyRemaining.Set(result.ConcurrencyRemaining)
+	}
+}
@@ -0,0 +1,82 @@
+package promzenrows_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	mocks "github.com/renatoaraujo/go-zenrows/mocks"
+	"github.com/renatoaraujo/go-zenrows/promzenrows"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollector(t *testing.T) {
+	httpClientMock := mocks.NewHttpClient(t)
+	httpClientMock.On("Do", mock.Anything).
+		Once().
+		Return(&http.Response{
+			StatusCode: 200,
+			Header: http.Header{
+				"X-Request-Cost":        []string{"5"},
+				"Concurrency-Limit":     []string{"25"},
+				"Concurrency-Remaining": []string{"24"},
+			},
+			Body: io.NopCloser(bytes.NewReader([]byte("some content"))),
+		}, nil)
+	httpClientMock.On("Do", mock.Anything).
+		Once().
+		Return(nil, errors.New("connection refused"))
+
+	collector := promzenrows.NewCollector()
+	registry := prometheus.NewPedanticRegistry()
+	require.NoError(t, registry.Register(collector))
+
+	client := zenrows.NewClient(httpClientMock).
+		WithApiKey("key").
+		WithObserver(collector)
+
+	_, err := client.Scrape(context.Background(), "https://example.com/page")
+	require.NoError(t, err)
+	_, err = client.Scrape(context.Background(), "https://example.com/other")
+	require.Error(t, err)
+
+	expected := `
+# HELP zenrows_concurrency_limit Concurrency limit of the ZenRows plan, as reported by the last response.
+# TYPE zenrows_concurrency_limit gauge
+zenrows_concurrency_limit 25
+# HELP zenrows_concurrency_remaining Remaining ZenRows concurrency, as reported by the last response.
+# TYPE zenrows_concurrency_remaining gauge
+zenrows_concurrency_remaining 24
+# HELP zenrows_credits_total ZenRows credits spent by target host.
+# TYPE zenrows_credits_total counter
+zenrows_credits_total{host="example.com"} 5
+# HELP zenrows_requests_in_flight Number of ZenRows scrapes in flight.
+# TYPE zenrows_requests_in_flight gauge
+zenrows_requests_in_flight 0
+# HELP zenrows_requests_total Number of ZenRows scrapes by status, error class and target host.
+# TYPE zenrows_requests_total counter
+zenrows_requests_total{error_class="",host="example.com",status="200"} 1
+zenrows_requests_total{error_class="transport",host="example.com",status=""} 1
+`
+	err = testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"zenrows_concurrency_limit",
+		"zenrows_concurrency_remaining",
+		"zenrows_credits_total",
+		"zenrows_requests_in_flight",
+		"zenrows_requests_total",
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, testutil.CollectAndCount(collector, "zenrows_response_size_bytes"))
+	assert.Equal(t, 1, testutil.CollectAndCount(collector, "zenrows_request_duration_seconds"))
+}
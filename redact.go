@@ -0,0 +1,116 @@
+package zenrows
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const redacted = "REDACTED"
+
+// RedactedURL wraps a ZenRows API URL so that printing it never reveals the apikey.
+//
+// Example usage:
+//
+//	u := zenrows.ApplyParameters(apiURL, zenrows.WithJSRender())
+//	log.Printf("calling %s", zenrows.RedactedURL{URL: u})
+type RedactedURL struct {
+	*url.URL
+}
+
+// String returns the URL with the apikey query parameter replaced.
+func (u RedactedURL) String() string {
+	if u.URL == nil {
+		return ""
+	}
+	return redactURL(u.URL)
+}
+
+func redactURL(u *url.URL) string {
+	values := u.Query()
+	if !values.Has("apikey") {
+		return u.String()
+	}
+	values.Set("apikey", redacted)
+	c := *u
+	c.RawQuery = values.Encode()
+	return c.String()
+}
+
+var (
+	// urlPattern matches the URLs embedded in a text, e.g. an error message.
+	urlPattern = regexp.MustCompile(`https?://[^\s"'<>]+`)
+	// apikeyParam matches the value of the apikey query parameter of a URL.
+	apikeyParam = regexp.MustCompile(`([?&]apikey=)[^&#]*`)
+	// keyToken matches the words of a text that may hold an apikey.
+	keyToken = regexp.MustCompile(`[A-Za-z0-9_.~%-]+`)
+)
+
+// redactText hides the apikey from a text.
+// The apikey query parameter of the URLs in the text is replaced, while the rest of the text only has the words
+// equal to the key replaced, so that a short key doesn't mangle unrelated text.
+func redactText(text, key string) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range urlPattern.FindAllStringIndex(text, -1) {
+		b.WriteString(redactWords(text[last:loc[0]], key))
+		b.WriteString(apikeyParam.ReplaceAllString(text[loc[0]:loc[1]], "${1}"+redacted))
+		last = loc[1]
+	}
+	b.WriteString(redactWords(text[last:], key))
+	return b.String()
+}
+
+func redactWords(text, key string) string {
+	escaped := url.QueryEscape(key)
+	if keyToken.FindString(key) != key {
+		return strings.NewReplacer(key, redacted, escaped, redacted).Replace(text)
+	}
+	return keyToken.ReplaceAllStringFunc(text, func(word string) string {
+		if word == key || word == escaped {
+			return redacted
+		}
+		return word
+	})
+}
+
+// redactedError hides the apikey from the message of the wrapped error.
+type redactedError struct {
+	err  error
+	keys []string
+}
+
+func (e *redactedError) Error() string {
+	msg := e.err.Error()
+	for _, key := range e.keys {
+		msg = redactText(msg, key)
+	}
+	return msg
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
+
+// redactError makes sure the apikeys don't leak through the error message, including the URL of any *url.Error.
+func (c *Client) redactError(err error, keys ...string) error {
+	var nonEmpty []string
+	for _, key := range keys {
+		if key != "" {
+			nonEmpty = append(nonEmpty, key)
+		}
+	}
+	if err == nil || len(nonEmpty) == 0 {
+		return err
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		if u, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+			urlErr.URL = redactURL(u)
+		}
+	}
+
+	return &redactedError{err: err, keys: nonEmpty}
+}
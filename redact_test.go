@@ -0,0 +1,106 @@
+package zenrows_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	mocks "github.com/renatoaraujo/go-zenrows/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactedURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		expected string
+	}{
+		{
+			"URL with apikey",
+			"https://api.zenrows.com/v1/?apikey=secret-key&url=https%3A%2F%2Fexample.com",
+			"https://api.zenrows.com/v1/?apikey=REDACTED&url=https%3A%2F%2Fexample.com",
+		},
+		{
+			"URL without apikey",
+			"https://api.zenrows.com/v1/?url=https%3A%2F%2Fexample.com",
+			"https://api.zenrows.com/v1/?url=https%3A%2F%2Fexample.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := url.Parse(tt.url)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, zenrows.RedactedURL{URL: u}.String())
+		})
+	}
+
+	assert.Equal(t, "", zenrows.RedactedURL{}.String())
+}
+
+func TestScrapeRedactsErrors(t *testing.T) {
+	httpClientMock := mocks.NewHttpClient(t)
+	httpClientMock.On("Do", mock.Anything).
+		Once().
+		Return(func(req *http.Request) (*http.Response, error) {
+			return nil, &url.Error{Op: "Get", URL: req.URL.String(), Err: errors.New("connection refused")}
+		})
+
+	client := zenrows.NewClient(httpClientMock).WithApiKey("secret-key")
+	_, err := client.Scrape(context.Background(), "https://example.com")
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "secret-key")
+	assert.Contains(t, err.Error(), "apikey=REDACTED")
+
+	var urlErr *url.Error
+	require.True(t, errors.As(err, &urlErr))
+	assert.NotContains(t, urlErr.URL, "secret-key")
+}
+
+func TestScrapeRedactsShortKey(t *testing.T) {
+	httpClientMock := mocks.NewHttpClient(t)
+	httpClientMock.On("Do", mock.Anything).
+		Once().
+		Return(func(req *http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("request to %s failed: abcd rejected key abc", req.URL.String())
+		})
+
+	client := zenrows.NewClient(httpClientMock).WithApiKey("abc")
+	_, err := client.Scrape(context.Background(), "https://abc.example.com/abc")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "apikey=REDACTED&url=https%3A%2F%2Fabc.example.com%2Fabc failed: abcd rejected key REDACTED")
+}
+
+func TestScrapeWithApiKeyHeader(t *testing.T) {
+	httpClientMock := mocks.NewHttpClient(t)
+	httpClientMock.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Header.Get("X-Api-Key") == "secret-key" && !req.URL.Query().Has("apikey")
+	})).
+		Once().
+		Return(&http.Response{
+			StatusCode: 200,
+			Body:       io.NopCloser(bytes.NewReader([]byte("some content"))),
+		}, nil)
+
+	client := zenrows.NewClient(httpClientMock).
+		WithApiKey("secret-key").
+		WithApiKeyHeader("X-Api-Key")
+
+	content, err := client.Scrape(context.Background(), "https://example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "some content", content)
+
+	req, err := client.DryRun("https://example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "REDACTED", req.Header.Get("X-Api-Key"))
+	assert.NotContains(t, req.Curl(), "secret-key")
+}
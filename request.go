@@ -0,0 +1,242 @@
+package zenrows
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+)
+
+// ScrapeRequest is an inspectable representation of a set of ScrapeOptions.
+//
+// Unlike ScrapeOptions, which are opaque functions, a ScrapeRequest can be printed, compared, hashed
+// and serialised to JSON or YAML, e.g. to store in a job queue which options a scrape used.
+// Use NewScrapeRequest to build it from ScrapeOptions and Option to convert it back.
+//
+// Boolean and numeric fields are pointers, nil when the parameter is not set, so that an explicit false or 0
+// survives a round trip and can override a client default option. Use Bool and Int to set them.
+//
+// Parameters without a dedicated field are kept in Extra. Extra must not contain the name of a typed field,
+// Validate reports such keys and Values ignores them. The apikey and url parameters are never stored.
+type ScrapeRequest struct {
+	JSRender       *bool             `json:"js_render,omitempty" yaml:"js_render,omitempty"`
+	JSInstructions string            `json:"js_instructions,omitempty" yaml:"js_instructions,omitempty"`
+	CustomHeaders  *bool             `json:"custom_headers,omitempty" yaml:"custom_headers,omitempty"`
+	PremiumProxy   *bool             `json:"premium_proxy,omitempty" yaml:"premium_proxy,omitempty"`
+	ProxyCountry   string            `json:"proxy_country,omitempty" yaml:"proxy_country,omitempty"`
+	BlockResources string            `json:"block_resources,omitempty" yaml:"block_resources,omitempty"`
+	JSONResponse   *bool             `json:"json_response,omitempty" yaml:"json_response,omitempty"`
+	WindowWidth    *int              `json:"window_width,omitempty" yaml:"window_width,omitempty"`
+	WindowHeight   *int              `json:"window_height,omitempty" yaml:"window_height,omitempty"`
+	CSSExtractor   string            `json:"css_extractor,omitempty" yaml:"css_extractor,omitempty"`
+	Autoparse      *bool             `json:"autoparse,omitempty" yaml:"autoparse,omitempty"`
+	ResolveCaptcha *bool             `json:"resolve_captcha,omitempty" yaml:"resolve_captcha,omitempty"`
+	Device         string            `json:"device,omitempty" yaml:"device,omitempty"`
+	OriginalStatus *bool             `json:"original_status,omitempty" yaml:"original_status,omitempty"`
+	WaitFor        string            `json:"wait_for,omitempty" yaml:"wait_for,omitempty"`
+	Wait           *int              `json:"wait,omitempty" yaml:"wait,omitempty"`
+	SessionID      *int              `json:"session_id,omitempty" yaml:"session_id,omitempty"`
+	AIAntiBot      *bool             `json:"antibot,omitempty" yaml:"antibot,omitempty"`
+	ResponseType   string            `json:"response_type,omitempty" yaml:"response_type,omitempty"`
+	Extra          map[string]string `json:"extra,omitempty" yaml:"extra,omitempty"`
+}
+
+// typedParams are the parameters stored in a dedicated field of ScrapeRequest, or never stored at all.
+var typedParams = map[string]bool{
+	"apikey": true, "url": true, "js_render": true, "js_instructions": true, "custom_headers": true,
+	"premium_proxy": true, "proxy_country": true, "block_resources": true, "json_response": true,
+	"window_width": true, "window_height": true, "css_extractor": true, "autoparse": true,
+	"resolve_captcha": true, "device": true, "original_status": true, "wait_for": true, "wait": true,
+	"session_id": true, "antibot": true, "response_type": true,
+}
+
+// Bool returns a pointer to the given boolean, to set the boolean fields of a ScrapeRequest.
+func Bool(value bool) *bool {
+	return &value
+}
+
+// Int returns a pointer to the given integer, to set the numeric fields of a ScrapeRequest.
+func Int(value int) *int {
+	return &value
+}
+
+// NewScrapeRequest builds a ScrapeRequest from the given ScrapeOptions.
+//
+// params: The ScrapeOptions to be represented.
+func NewScrapeRequest(params ...ScrapeOptions) (ScrapeRequest, error) {
+	values := url.Values{}
+	for _, param := range params {
+		param(values)
+	}
+	return ScrapeRequestFromValues(values)
+}
+
+// ScrapeRequestFromValues builds a ScrapeRequest from ZenRows query parameters.
+// It returns an error if a numeric or boolean parameter has an invalid value.
+//
+// values: The ZenRows query parameters.
+func ScrapeRequestFromValues(values url.Values) (ScrapeRequest, error) {
+	var r ScrapeRequest
+	for name := range values {
+		value := values.Get(name)
+		var err error
+		switch name {
+		case "apikey", "url":
+		case "js_render":
+			r.JSRender, err = parseBoolPtr(value)
+		case "js_instructions":
+			r.JSInstructions = value
+		case "custom_headers":
+			r.CustomHeaders, err = parseBoolPtr(value)
+		case "premium_proxy":
+			r.PremiumProxy, err = parseBoolPtr(value)
+		case "proxy_country":
+			r.ProxyCountry = value
+		case "block_resources":
+			r.BlockResources = value
+		case "json_response":
+			r.JSONResponse, err = parseBoolPtr(value)
+		case "window_width":
+			r.WindowWidth, err = parseIntPtr(value)
+		case "window_height":
+			r.WindowHeight, err = parseIntPtr(value)
+		case "css_extractor":
+			r.CSSExtractor = value
+		case "autoparse":
+			r.Autoparse, err = parseBoolPtr(value)
+		case "resolve_captcha":
+			r.ResolveCaptcha, err = parseBoolPtr(value)
+		case "device":
+			r.Device = value
+		case "original_status":
+			r.OriginalStatus, err = parseBoolPtr(value)
+		case "wait_for":
+			r.WaitFor = value
+		case "wait":
+			r.Wait, err = parseIntPtr(value)
+		case "session_id":
+			r.SessionID, err = parseIntPtr(value)
+		case "antibot":
+			r.AIAntiBot, err = parseBoolPtr(value)
+		case "response_type":
+			r.ResponseType = value
+		default:
+			if r.Extra == nil {
+				r.Extra = map[string]string{}
+			}
+			r.Extra[name] = value
+		}
+		if err != nil {
+			return ScrapeRequest{}, fmt.Errorf("invalid value %q for parameter %s: %w", value, name, err)
+		}
+	}
+	return r, nil
+}
+
+func parseBoolPtr(value string) (*bool, error) {
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func parseIntPtr(value string) (*int, error) {
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, err
+	}
+	return &i, nil
+}
+
+// Values returns the ZenRows query parameters represented by the request.
+// Extra keys naming a typed field are ignored, the typed field always wins.
+func (r ScrapeRequest) Values() url.Values {
+	values := url.Values{}
+	for name, value := range r.Extra {
+		if !typedParams[name] {
+			values.Set(name, value)
+		}
+	}
+	setBool := func(name string, b *bool) {
+		if b != nil {
+			values.Set(name, strconv.FormatBool(*b))
+		}
+	}
+	setString := func(name, s string) {
+		if s != "" {
+			values.Set(name, s)
+		}
+	}
+	setInt := func(name string, i *int) {
+		if i != nil {
+			values.Set(name, strconv.Itoa(*i))
+		}
+	}
+
+	setBool("js_render", r.JSRender)
+	setString("js_instructions", r.JSInstructions)
+	setBool("custom_headers", r.CustomHeaders)
+	setBool("premium_proxy", r.PremiumProxy)
+	setString("proxy_country", r.ProxyCountry)
+	setString("block_resources", r.BlockResources)
+	setBool("json_response", r.JSONResponse)
+	setInt("window_width", r.WindowWidth)
+	setInt("window_height", r.WindowHeight)
+	setString("css_extractor", r.CSSExtractor)
+	setBool("autoparse", r.Autoparse)
+	setBool("resolve_captcha", r.ResolveCaptcha)
+	setString("device", r.Device)
+	setBool("original_status", r.OriginalStatus)
+	setString("wait_for", r.WaitFor)
+	setInt("wait", r.Wait)
+	setInt("session_id", r.SessionID)
+	setBool("antibot", r.AIAntiBot)
+	setString("response_type", r.ResponseType)
+	return values
+}
+
+// Option converts the request back into a ScrapeOptions that sets all of its parameters.
+func (r ScrapeRequest) Option() ScrapeOptions {
+	return func(values url.Values) {
+		for name, value := range r.Values() {
+			values[name] = value
+		}
+	}
+}
+
+// String returns the request as an encoded query string, sorted by parameter name.
+func (r ScrapeRequest) String() string {
+	return r.Values().Encode()
+}
+
+// Equal reports whether both requests represent the same ZenRows parameters.
+func (r ScrapeRequest) Equal(other ScrapeRequest) bool {
+	return r.String() == other.String()
+}
+
+// Hash returns a stable SHA-256 hex digest of the request parameters.
+func (r ScrapeRequest) Hash() string {
+	sum := sha256.Sum256([]byte(r.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// Diff returns the sorted names of the parameters that differ between both requests.
+func (r ScrapeRequest) Diff(other ScrapeRequest) []string {
+	a, b := r.Values(), other.Values()
+	var names []string
+	for name := range a {
+		if a.Get(name) != b.Get(name) {
+			names = append(names, name)
+		}
+	}
+	for name := range b {
+		if _, ok := a[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
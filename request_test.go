@@ -0,0 +1,143 @@
+package zenrows_test
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewScrapeRequest(t *testing.T) {
+	yes := true
+	no := false
+
+	tests := []struct {
+		name     string
+		options  []zenrows.ScrapeOptions
+		expected zenrows.ScrapeRequest
+	}{
+		{
+			"No options",
+			nil,
+			zenrows.ScrapeRequest{},
+		},
+		{
+			"WithProxyCountry",
+			[]zenrows.ScrapeOptions{zenrows.WithProxyCountry("US")},
+			zenrows.ScrapeRequest{PremiumProxy: zenrows.Bool(true), ProxyCountry: "US"},
+		},
+		{
+			"Boolean options keep explicit false",
+			[]zenrows.ScrapeOptions{zenrows.WithAutoparse(false), zenrows.WithJSONResponse(true)},
+			zenrows.ScrapeRequest{JSRender: &yes, Autoparse: &no, JSONResponse: &yes},
+		},
+		{
+			"Numeric options",
+			[]zenrows.ScrapeOptions{zenrows.WithWindowWidth(1920), zenrows.WithWait(500), zenrows.WithSessionID(42)},
+			zenrows.ScrapeRequest{JSRender: &yes, WindowWidth: zenrows.Int(1920), Wait: zenrows.Int(500), SessionID: zenrows.Int(42)},
+		},
+		{
+			"Explicit zero values are kept",
+			[]zenrows.ScrapeOptions{zenrows.WithParameter("js_render", "false"), zenrows.WithParameter("wait", "0")},
+			zenrows.ScrapeRequest{JSRender: &no, Wait: zenrows.Int(0)},
+		},
+		{
+			"Unknown parameters are kept in extra",
+			[]zenrows.ScrapeOptions{zenrows.WithParameter("outputs", "emails")},
+			zenrows.ScrapeRequest{Extra: map[string]string{"outputs": "emails"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := zenrows.NewScrapeRequest(tt.options...)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, req)
+		})
+	}
+}
+
+func TestScrapeRequestFromValues(t *testing.T) {
+	t.Run("Ignores credentials and target url", func(t *testing.T) {
+		req, err := zenrows.ScrapeRequestFromValues(url.Values{
+			"apikey": []string{"key"},
+			"url":    []string{"https://example.com"},
+			"device": []string{"mobile"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, zenrows.ScrapeRequest{Device: "mobile"}, req)
+	})
+
+	t.Run("Fails on invalid values", func(t *testing.T) {
+		_, err := zenrows.ScrapeRequestFromValues(url.Values{"wait": []string{"soon"}})
+		require.Error(t, err)
+	})
+}
+
+func TestScrapeRequestRoundTrip(t *testing.T) {
+	options := []zenrows.ScrapeOptions{
+		zenrows.WithJSInstructions(`[{"click": ".selector"}]`),
+		zenrows.WithCustomHeaders(false),
+		zenrows.WithProxyCountry("US"),
+		zenrows.WithBlockResources("image,font"),
+		zenrows.WithWindowHeight(1080),
+		zenrows.WithDevice("mobile"),
+		zenrows.WithAIAntiBot(),
+		zenrows.WithResponseType(zenrows.ResponseTypeMarkdown),
+		zenrows.WithoutParameters("premium_proxy"),
+		zenrows.WithParameter("premium_proxy", "false"),
+		zenrows.WithWait(0),
+	}
+
+	original := url.Values{}
+	for _, option := range options {
+		option(original)
+	}
+
+	req, err := zenrows.NewScrapeRequest(options...)
+	require.NoError(t, err)
+	assert.Equal(t, original, req.Values())
+
+	applied := url.Values{}
+	req.Option()(applied)
+	assert.Equal(t, original, applied)
+
+	encoded, err := json.Marshal(req)
+	require.NoError(t, err)
+
+	var decoded zenrows.ScrapeRequest
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	assert.True(t, req.Equal(decoded))
+	assert.Equal(t, req.Hash(), decoded.Hash())
+	assert.Equal(t, original.Encode(), decoded.String())
+}
+
+func TestScrapeRequestExtra(t *testing.T) {
+	req := zenrows.ScrapeRequest{
+		Device: "mobile",
+		Extra:  map[string]string{"device": "desktop", "outputs": "emails"},
+	}
+
+	assert.Equal(t, url.Values{"device": []string{"mobile"}, "outputs": []string{"emails"}}, req.Values())
+
+	err := req.Validate()
+	require.Error(t, err)
+	var optionErr *zenrows.OptionError
+	require.ErrorAs(t, err, &optionErr)
+	assert.Equal(t, "device", optionErr.Param)
+}
+
+func TestScrapeRequestDiff(t *testing.T) {
+	a, err := zenrows.NewScrapeRequest(zenrows.WithProxyCountry("US"), zenrows.WithDevice("mobile"))
+	require.NoError(t, err)
+	b, err := zenrows.NewScrapeRequest(zenrows.WithProxyCountry("BR"), zenrows.WithJSRender())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"device", "js_render", "proxy_country"}, a.Diff(b))
+	assert.Empty(t, a.Diff(a))
+	assert.False(t, a.Equal(b))
+	assert.NotEqual(t, a.Hash(), b.Hash())
+}
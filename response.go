@@ -0,0 +1,52 @@
+package zenrows
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// Headers returned by the ZenRows API describing the request.
+const (
+	headerRequestCost          = "X-Request-Cost"
+	headerConcurrencyLimit     = "Concurrency-Limit"
+	headerConcurrencyRemaining = "Concurrency-Remaining"
+	headerFinalURL             = "Zr-Final-Url"
+)
+
+// Response is the response of the ZenRows API to a scrape.
+type Response struct {
+	// StatusCode is the status returned by ZenRows.
+	StatusCode int
+	// Header contains the headers returned by ZenRows.
+	Header http.Header
+	// Body is the scraped content.
+	Body string
+}
+
+// Cost returns the credits spent on the request, or -1 when ZenRows didn't report it.
+func (r *Response) Cost() float64 {
+	return headerFloat(r.Header, headerRequestCost)
+}
+
+// ConcurrencyLimit returns the concurrency of the ZenRows plan, or -1 when ZenRows didn't report it.
+func (r *Response) ConcurrencyLimit() float64 {
+	return headerFloat(r.Header, headerConcurrencyLimit)
+}
+
+// ConcurrencyRemaining returns how many concurrent requests are left, or -1 when ZenRows didn't report it.
+func (r *Response) ConcurrencyRemaining() float64 {
+	return headerFloat(r.Header, headerConcurrencyRemaining)
+}
+
+// FinalURL returns the URL of the scraped page after redirects, or an empty string when ZenRows didn't report it.
+func (r *Response) FinalURL() string {
+	return r.Header.Get(headerFinalURL)
+}
+
+func headerFloat(header http.Header, name string) float64 {
+	f, err := strconv.ParseFloat(header.Get(name), 64)
+	if err != nil {
+		return -1
+	}
+	return f
+}
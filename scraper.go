@@ -24,10 +24,13 @@ func validateFullURL(targetURL string) error {
 // Scrape fetches content from the specified targetURL using the ZenRows API.
 //
 // The function constructs the API URL based on the provided targetURL and optional ScrapeOptions.
-// It then sends a GET request to the ZenRows API and returns the scraped content as a string.
+// Default options configured on the client are applied first, so the ones given here override them.
+// It then sends a GET request to the ZenRows API, through the client middlewares, and returns the scraped content as a string.
+// Use Execute to get the full response, including its status and headers.
 //
 // The function validates the provided targetURL to ensure it's a full URL with both a scheme and a host.
-// It also checks if the 'js_instructions' parameter is set without enabling 'js_render', and returns an error if so.
+// Before sending anything it validates the options, see ValidateValues, so no credit is spent on a request
+// with invalid or conflicting options such as 'js_instructions' without 'js_render'.
 //
 // # For now only supports GET method
 //
@@ -40,6 +43,8 @@ func validateFullURL(targetURL string) error {
 // - A string containing the scraped content.
 // - An error if there's any issue during the scraping process, such as invalid URLs, failed requests, or reading issues.
 //
+// The apikey is always redacted from returned errors.
+//
 // Example usage:
 //
 //	content, err := client.Scrape(context.Background(), "https://example.com", zenrows.WithJSRender(true))
@@ -50,55 +55,107 @@ func validateFullURL(targetURL string) error {
 //
 // For more details and examples, refer to the https://pkg.go.dev/github.com/renatoaraujo/go-zenrows and the example provided in the repository https://github.com/renatoaraujo/go-zenrows/blob/main/examples/example.go.
 func (c *Client) Scrape(ctx context.Context, targetURL string, params ...ScrapeOptions) (string, error) {
-	select {
-	case <-ctx.Done():
-		return "", ctx.Err()
-	default:
+	resp, err := c.Execute(ctx, &Call{TargetURL: targetURL, Options: params})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Body, nil
+}
+
+// send is the innermost Handler, making the actual request to the ZenRows API.
+func (c *Client) send(ctx context.Context, call *Call) (*Response, error) {
+	apiURL, err := c.prepareAPIURL(call)
+	if err != nil {
+		return nil, &validationError{err: err}
+	}
+
+	return c.fetchContent(ctx, apiURL, call)
+}
+
+func (c *Client) scrapeFinished(ctx context.Context, info ScrapeInfo, result ScrapeResult, sampled bool) {
+	c.logScrapeFinished(ctx, info, result, sampled)
+	c.notifyScrapeFinished(ctx, info, result)
+}
+
+func (c *Client) apiKey(call *Call) string {
+	if call.APIKey != "" {
+		return call.APIKey
 	}
+	return c.config.key
+}
 
-	if err := validateFullURL(targetURL); err != nil {
-		return "", fmt.Errorf("failed to parse target url: %w", err)
+func (c *Client) prepareAPIURL(call *Call) (*url.URL, error) {
+	if err := validateFullURL(call.TargetURL); err != nil {
+		return nil, fmt.Errorf("failed to parse target url: %w", err)
 	}
 
-	apiURL, err := c.constructAPIURL(targetURL, params...)
+	apiURL, err := c.constructAPIURL(call.TargetURL, c.apiKey(call), call.Options...)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	if err := ValidateValues(apiURL.Query()); err != nil {
+		return nil, fmt.Errorf("invalid scrape options: %w", err)
 	}
 
-	return c.fetchContent(ctx, apiURL)
+	return apiURL, nil
 }
 
-func (c *Client) constructAPIURL(targetURL string, params ...ScrapeOptions) (*url.URL, error) {
+func (c *Client) constructAPIURL(targetURL, key string, params ...ScrapeOptions) (*url.URL, error) {
 	baseURL, err := url.Parse(c.config.BaseURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse base zenrows url: %w", err)
 	}
 
 	addTokenParams := func(values url.Values) {
-		values.Add("apikey", c.config.key)
+		if c.config.keyHeader == "" {
+			values.Add("apikey", key)
+		}
 		values.Add("url", targetURL)
 	}
 
-	allParams := append([]ScrapeOptions{addTokenParams}, params...)
+	allParams := make([]ScrapeOptions, 0, len(c.config.DefaultOptions)+len(params)+1)
+	allParams = append(allParams, addTokenParams)
+	allParams = append(allParams, c.config.DefaultOptions...)
+	allParams = append(allParams, params...)
 	return ApplyParameters(baseURL, allParams...), nil
 }
 
-func (c *Client) fetchContent(ctx context.Context, apiURL *url.URL) (string, error) {
+func (c *Client) newRequest(ctx context.Context, apiURL *url.URL, call *Call) (*http.Request, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL.String(), nil)
 	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	for name, values := range call.Header {
+		req.Header[name] = append([]string(nil), values...)
+	}
+	if c.config.keyHeader != "" {
+		req.Header.Set(c.config.keyHeader, c.apiKey(call))
+	}
+	return req, nil
+}
+
+func (c *Client) fetchContent(ctx context.Context, apiURL *url.URL, call *Call) (*Response, error) {
+	req, err := c.newRequest(ctx, apiURL, call)
+	if err != nil {
+		return nil, err
 	}
 
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("failed to make request: %w", err)
+		return nil, &transportError{err: fmt.Errorf("failed to make request: %w", err)}
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return "", fmt.Errorf("failed to read response body: %w", err)
+		return nil, &transportError{err: fmt.Errorf("failed to read response body: %w", err)}
 	}
 
-	return string(body), nil
+	return &Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       string(body),
+	}, nil
 }
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"net/url"
 	"testing"
 	"time"
 
@@ -22,6 +23,7 @@ func TestScrape(t *testing.T) {
 		name            string
 		timeoutDuration time.Duration
 		url             string
+		options         []zenrows.ScrapeOptions
 		httpClientSetup func(client *mocks.HttpClient)
 		result          string
 		expectError     bool
@@ -69,6 +71,12 @@ func TestScrape(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name:        "Failed with invalid options without making the request",
+			url:         "http://example.com",
+			options:     []zenrows.ScrapeOptions{zenrows.WithWait(-1)},
+			expectError: true,
+		},
 		{
 			name:        "Failed to scrape with valid url",
 			url:         "invalid",
@@ -93,7 +101,7 @@ func TestScrape(t *testing.T) {
 
 			client := zenrows.NewClient(httpClientMock).
 				WithApiKey("key")
-			content, err := client.Scrape(ctx, tt.url)
+			content, err := client.Scrape(ctx, tt.url, tt.options...)
 
 			if tt.expectError {
 				require.Error(t, err)
@@ -105,3 +113,55 @@ func TestScrape(t *testing.T) {
 		})
 	}
 }
+
+func TestScrapeWithDefaultOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		defaults []zenrows.ScrapeOptions
+		params   []zenrows.ScrapeOptions
+		expected url.Values
+	}{
+		{
+			name:     "Defaults are applied",
+			defaults: []zenrows.ScrapeOptions{zenrows.WithProxyCountry("US"), zenrows.WithDevice("mobile")},
+			expected: url.Values{"premium_proxy": []string{"true"}, "proxy_country": []string{"US"}, "device": []string{"mobile"}},
+		},
+		{
+			name:     "Per-call options override defaults",
+			defaults: []zenrows.ScrapeOptions{zenrows.WithProxyCountry("US")},
+			params:   []zenrows.ScrapeOptions{zenrows.WithProxyCountry("BR")},
+			expected: url.Values{"premium_proxy": []string{"true"}, "proxy_country": []string{"BR"}},
+		},
+		{
+			name:     "Defaults can be unset per call",
+			defaults: []zenrows.ScrapeOptions{zenrows.WithProxyCountry("US"), zenrows.WithDevice("mobile")},
+			params:   []zenrows.ScrapeOptions{zenrows.WithoutParameters("premium_proxy", "proxy_country")},
+			expected: url.Values{"device": []string{"mobile"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent url.Values
+			httpClientMock := mocks.NewHttpClient(t)
+			httpClientMock.On("Do", mock.Anything).
+				Once().
+				Run(func(args mock.Arguments) {
+					sent = args.Get(0).(*http.Request).URL.Query()
+				}).
+				Return(&http.Response{
+					StatusCode: 200,
+					Body:       io.NopCloser(bytes.NewReader([]byte("some content"))),
+				}, nil)
+
+			client := zenrows.NewClient(httpClientMock, tt.defaults...).
+				WithApiKey("key")
+			_, err := client.Scrape(context.Background(), "http://example.com", tt.params...)
+			require.NoError(t, err)
+
+			tt.expected.Set("apikey", "key")
+			tt.expected.Set("url", "http://example.com")
+			assert.Equal(t, tt.expected, sent)
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package zenrows
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultSessionTTL is the age at which a session ID is rotated, before ZenRows releases its IP after 10 minutes.
+	DefaultSessionTTL = 9 * time.Minute
+	// DefaultSessionMaxFailures is the number of consecutive failures after which a session ID is rotated.
+	DefaultSessionMaxFailures = 1
+)
+
+var (
+	// ErrNoSessionAvailable is returned when every session ID supported by ZenRows is already in use.
+	ErrNoSessionAvailable = errors.New("no session id available")
+	// ErrSessionClosed is returned when using a Session after Close.
+	ErrSessionClosed = errors.New("session is closed")
+)
+
+// SessionManager allocates unique session IDs for WithSessionID and rotates them before they expire
+// or after failures, so requests made through a Session keep the same IP while it's healthy.
+type SessionManager struct {
+	client      *Client
+	ttl         time.Duration
+	maxFailures int
+	cookies     bool
+
+	mu    sync.Mutex
+	inUse map[int]struct{}
+}
+
+// NewSessionManager creates a SessionManager making requests with the given client.
+func NewSessionManager(client *Client) *SessionManager {
+	return &SessionManager{
+		client:      client,
+		ttl:         DefaultSessionTTL,
+		maxFailures: DefaultSessionMaxFailures,
+		inUse:       map[int]struct{}{},
+	}
+}
+
+// WithTTL Configures the age at which session IDs are rotated
+func (m *SessionManager) WithTTL(ttl time.Duration) *SessionManager {
+	m.ttl = ttl
+	return m
+}
+
+// WithMaxFailures Configures the number of consecutive failures after which session IDs are rotated
+func (m *SessionManager) WithMaxFailures(n int) *SessionManager {
+	m.maxFailures = n
+	return m
+}
+
+// NewSession creates a Session with a newly allocated session ID.
+// The Session has to be closed to release its ID.
+func (m *SessionManager) NewSession() (*Session, error) {
+	s := &Session{manager: m}
+	if m.cookies {
+		s.jar = newCookieJar()
+	}
+	if err := s.Rotate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// allocate reserves an unused session ID, releasing the previous one of the session if any.
+// The previous ID is only reused when it's the last one free.
+func (m *SessionManager) allocate(previous int) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.inUse, previous)
+	if len(m.inUse) >= maxSessionID {
+		return 0, ErrNoSessionAvailable
+	}
+	// Scan the IDs from a random one, so sessions don't all start from the same IP.
+	start := rand.Intn(maxSessionID)
+	for i := 0; i < maxSessionID; i++ {
+		id := (start+i)%maxSessionID + 1
+		if _, ok := m.inUse[id]; !ok && id != previous {
+			m.inUse[id] = struct{}{}
+			return id, nil
+		}
+	}
+	m.inUse[previous] = struct{}{}
+	return previous, nil
+}
+
+func (m *SessionManager) release(id int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.inUse, id)
+}
+
+// Session is a sticky ZenRows session: every scrape made through it uses its current session ID,
+// so ZenRows uses the same IP for them.
+type Session struct {
+	manager *SessionManager
+
+	mu        sync.Mutex
+	id        int
+	createdAt time.Time
+	failures  int
+	closed    bool
+	jar       http.CookieJar
+}
+
+// ID returns the current session ID, rotating it first when it's too old.
+// It returns ErrSessionClosed once the session is closed.
+func (s *Session) ID() (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return 0, ErrSessionClosed
+	}
+	if s.id == 0 || time.Since(s.createdAt) >= s.manager.ttl {
+		if err := s.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	return s.id, nil
+}
+
+// Age returns for how long the current session ID has been used.
+func (s *Session) Age() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return time.Since(s.createdAt)
+}
+
+// Rotate replaces the session ID with a new one, e.g. when the current IP got blocked.
+// It returns ErrSessionClosed once the session is closed.
+func (s *Session) Rotate() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return ErrSessionClosed
+	}
+	return s.rotate()
+}
+
+func (s *Session) rotate() error {
+	id, err := s.manager.allocate(s.id)
+	if err != nil {
+		s.id = 0
+		return err
+	}
+	s.id = id
+	s.createdAt = time.Now()
+	s.failures = 0
+	return nil
+}
+
+// Close releases the session ID. The session can't be used afterwards, closing it again does nothing.
+func (s *Session) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.manager.release(s.id)
+	s.id = 0
+	s.closed = true
+}
+
+// Scrape works like Client.Scrape, using the current session ID and the session cookies, if enabled.
+func (s *Session) Scrape(ctx context.Context, targetURL string, params ...ScrapeOptions) (string, error) {
+	resp, err := s.Execute(ctx, &Call{TargetURL: targetURL, Options: params})
+	if err != nil {
+		return "", err
+	}
+	return resp.Body, nil
+}
+
+// Execute works like Client.Execute, using the current session ID and the session cookies, if enabled.
+//
+// Requests that could not reach ZenRows, and responses with a 403, 429 or 5xx status, count as failures
+// of the session; the session ID is rotated once the configured number of consecutive failures is reached.
+// Other errors, e.g. invalid options, say nothing about the IP and leave the session untouched.
+func (s *Session) Execute(ctx context.Context, call *Call) (*Response, error) {
+	id, err := s.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	jar := s.CookieJar()
+	withSession := *call
+	withSession.Options = append(append([]ScrapeOptions{}, call.Options...), WithSessionID(id))
+	if jar != nil {
+		withCookies(jar, &withSession)
+	}
+	resp, err := s.manager.client.Execute(ctx, &withSession)
+	if resp != nil && jar != nil {
+		storeCookies(jar, call.TargetURL, resp)
+	}
+
+	var transportErr *transportError
+	switch {
+	case ctx.Err() != nil:
+	case err != nil:
+		if errors.As(err, &transportErr) {
+			s.recordOutcome(id, true)
+		}
+	case resp != nil:
+		s.recordOutcome(id, sessionFailureStatus(resp.StatusCode))
+	}
+	return resp, err
+}
+
+func (s *Session) recordOutcome(id int, failed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.id != id {
+		// The session was rotated in the meantime.
+		return
+	}
+	if !failed {
+		s.failures = 0
+		return
+	}
+	s.failures++
+	if s.failures >= s.manager.maxFailures {
+		_ = s.rotate()
+	}
+}
+
+func sessionFailureStatus(status int) bool {
+	return status == http.StatusForbidden || status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
@@ -0,0 +1,193 @@
+package zenrows_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/renatoaraujo/go-zenrows"
+	mocks "github.com/renatoaraujo/go-zenrows/mocks"
+	"github.com/renatoaraujo/go-zenrows/zenrowstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionKeepsID(t *testing.T) {
+	srv := zenrowstest.NewServer("key")
+	defer srv.Close()
+	srv.HandleDefault(zenrowstest.Fixture{Body: "ok"})
+
+	manager := zenrows.NewSessionManager(srv.Client())
+	session, err := manager.NewSession()
+	require.NoError(t, err)
+	defer session.Close()
+
+	other, err := manager.NewSession()
+	require.NoError(t, err)
+	defer other.Close()
+
+	for i := 0; i < 3; i++ {
+		_, err := session.Scrape(context.Background(), "https://example.com", zenrows.WithSessionID(1))
+		require.NoError(t, err)
+	}
+	_, err = other.Scrape(context.Background(), "https://example.com")
+	require.NoError(t, err)
+
+	requests := srv.Requests()
+	require.Len(t, requests, 4)
+	id, err := session.ID()
+	require.NoError(t, err)
+	for _, req := range requests[:3] {
+		assert.Equal(t, zenrows.Int(id), req.Options.SessionID)
+	}
+	assert.NotEqual(t, zenrows.Int(id), requests[3].Options.SessionID)
+}
+
+func TestSessionRotatesWhenExpired(t *testing.T) {
+	manager := zenrows.NewSessionManager(zenrows.NewClient(http.DefaultClient)).
+		WithTTL(20 * time.Millisecond)
+	session, err := manager.NewSession()
+	require.NoError(t, err)
+	defer session.Close()
+
+	first, err := session.ID()
+	require.NoError(t, err)
+	same, err := session.ID()
+	require.NoError(t, err)
+	assert.Equal(t, first, same)
+
+	time.Sleep(30 * time.Millisecond)
+	rotated, err := session.ID()
+	require.NoError(t, err)
+	assert.NotEqual(t, first, rotated)
+	assert.Less(t, session.Age(), 20*time.Millisecond)
+}
+
+func TestSessionRotatesAfterFailures(t *testing.T) {
+	tests := []struct {
+		name        string
+		fixture     zenrowstest.Fixture
+		maxFailures int
+		rotateAfter int
+	}{
+		{"Blocked after one failure", zenrowstest.Fixture{StatusCode: http.StatusForbidden}, 1, 1},
+		{"Rate limited after two failures", zenrowstest.TooManyRequests(time.Second), 2, 2},
+		{"Target not found is not a failure", zenrowstest.Fixture{StatusCode: http.StatusNotFound}, 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := zenrowstest.NewServer("key")
+			defer srv.Close()
+			srv.HandleDefault(tt.fixture)
+
+			manager := zenrows.NewSessionManager(srv.Client()).WithMaxFailures(tt.maxFailures)
+			session, err := manager.NewSession()
+			require.NoError(t, err)
+			defer session.Close()
+
+			initial, err := session.ID()
+			require.NoError(t, err)
+			for i := 1; i <= 2; i++ {
+				_, err := session.Scrape(context.Background(), "https://example.com")
+				require.NoError(t, err)
+
+				id, err := session.ID()
+				require.NoError(t, err)
+				if tt.rotateAfter != 0 && i >= tt.rotateAfter {
+					assert.NotEqual(t, initial, id)
+					return
+				}
+				assert.Equal(t, initial, id)
+			}
+		})
+	}
+}
+
+func TestSessionIgnoresLocalErrors(t *testing.T) {
+	srv := zenrowstest.NewServer("key")
+	defer srv.Close()
+	srv.HandleDefault(zenrowstest.Fixture{Body: "ok"})
+
+	session, err := zenrows.NewSessionManager(srv.Client()).NewSession()
+	require.NoError(t, err)
+	defer session.Close()
+
+	initial, err := session.ID()
+	require.NoError(t, err)
+	_, err = session.Scrape(context.Background(), "https://example.com", zenrows.WithWait(-1))
+	require.Error(t, err)
+	_, err = session.Scrape(context.Background(), "not-a-url")
+	require.Error(t, err)
+
+	id, err := session.ID()
+	require.NoError(t, err)
+	assert.Equal(t, initial, id)
+	assert.Empty(t, srv.Requests())
+}
+
+func TestSessionRotatesAfterTransportErrors(t *testing.T) {
+	httpClientMock := mocks.NewHttpClient(t)
+	httpClientMock.On("Do", mock.Anything).Once().Return(nil, errors.New("connection reset"))
+
+	session, err := zenrows.NewSessionManager(zenrows.NewClient(httpClientMock).WithApiKey("key")).NewSession()
+	require.NoError(t, err)
+	defer session.Close()
+
+	initial, err := session.ID()
+	require.NoError(t, err)
+	_, err = session.Scrape(context.Background(), "https://example.com")
+	require.Error(t, err)
+
+	id, err := session.ID()
+	require.NoError(t, err)
+	assert.NotEqual(t, initial, id)
+}
+
+func TestSessionClose(t *testing.T) {
+	session, err := zenrows.NewSessionManager(zenrows.NewClient(http.DefaultClient)).NewSession()
+	require.NoError(t, err)
+
+	session.Close()
+	session.Close()
+
+	_, err = session.ID()
+	assert.ErrorIs(t, err, zenrows.ErrSessionClosed)
+	assert.ErrorIs(t, session.Rotate(), zenrows.ErrSessionClosed)
+	_, err = session.Scrape(context.Background(), "https://example.com")
+	assert.ErrorIs(t, err, zenrows.ErrSessionClosed)
+}
+
+func TestSessionManagerExhausted(t *testing.T) {
+	manager := zenrows.NewSessionManager(zenrows.NewClient(http.DefaultClient))
+	sessions := make([]*zenrows.Session, 0, 99999)
+	for {
+		session, err := manager.NewSession()
+		if errors.Is(err, zenrows.ErrNoSessionAvailable) {
+			break
+		}
+		require.NoError(t, err)
+		sessions = append(sessions, session)
+	}
+	require.Len(t, sessions, 99999)
+
+	// The previous ID is the only one free: rotating keeps it instead of spinning forever.
+	last := sessions[len(sessions)-1]
+	id, err := last.ID()
+	require.NoError(t, err)
+	require.NoError(t, last.Rotate())
+	rotated, err := last.ID()
+	require.NoError(t, err)
+	assert.Equal(t, id, rotated)
+
+	last.Close()
+	session, err := manager.NewSession()
+	require.NoError(t, err)
+	reused, err := session.ID()
+	require.NoError(t, err)
+	assert.Equal(t, id, reused)
+}
@@ -0,0 +1,69 @@
+package zenrows
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// Usage describes the consumption of the ZenRows account.
+type Usage struct {
+	APICreditLimit   float64 `json:"api_credit_limit"`
+	APICreditUsage   float64 `json:"api_credit_usage"`
+	ConcurrencyLimit int     `json:"concurrency_limit"`
+	ConcurrencyUsage int     `json:"concurrency_usage"`
+}
+
+// Usage fetches the credits and concurrency used by the account of the configured apikey.
+//
+// Returns:
+// - The account Usage.
+// - An error if the request fails or ZenRows answers with a non 2xx status. The apikey is always redacted from it.
+func (c *Client) Usage(ctx context.Context) (*Usage, error) {
+	usage, err := c.fetchUsage(ctx)
+	if err != nil {
+		return nil, c.redactError(err, c.config.key)
+	}
+	return usage, nil
+}
+
+func (c *Client) fetchUsage(ctx context.Context) (*Usage, error) {
+	baseURL, err := url.Parse(c.config.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse base zenrows url: %w", err)
+	}
+
+	usageURL := baseURL.JoinPath("usage")
+	if c.config.keyHeader == "" {
+		usageURL.RawQuery = url.Values{"apikey": []string{c.config.key}}.Encode()
+	}
+
+	req, err := c.newRequest(ctx, usageURL, &Call{})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("unexpected status %d fetching usage: %s", resp.StatusCode, body)
+	}
+
+	var usage Usage
+	if err := json.Unmarshal(body, &usage); err != nil {
+		return nil, fmt.Errorf("failed to decode usage: %w", err)
+	}
+	return &usage, nil
+}
@@ -0,0 +1,75 @@
+package zenrows_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	mocks "github.com/renatoaraujo/go-zenrows/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsage(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		expected    *zenrows.Usage
+		expectError bool
+	}{
+		{
+			name:   "Success fetching usage",
+			status: 200,
+			body:   `{"api_credit_limit": 250000, "api_credit_usage": 1234, "concurrency_limit": 25, "concurrency_usage": 2}`,
+			expected: &zenrows.Usage{
+				APICreditLimit:   250000,
+				APICreditUsage:   1234,
+				ConcurrencyLimit: 25,
+				ConcurrencyUsage: 2,
+			},
+		},
+		{
+			name:        "Unexpected status",
+			status:      401,
+			body:        `{"status": 401, "title": "Unauthorized"}`,
+			expectError: true,
+		},
+		{
+			name:        "Invalid body",
+			status:      200,
+			body:        `not json`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClientMock := mocks.NewHttpClient(t)
+			httpClientMock.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+				return req.URL.Path == "/v1/usage" && req.URL.Query().Get("apikey") == "secret-key"
+			})).
+				Once().
+				Return(&http.Response{
+					StatusCode: tt.status,
+					Body:       io.NopCloser(bytes.NewReader([]byte(tt.body))),
+				}, nil)
+
+			client := zenrows.NewClient(httpClientMock).WithApiKey("secret-key")
+			usage, err := client.Usage(context.Background())
+
+			if tt.expectError {
+				require.Error(t, err)
+				assert.NotContains(t, err.Error(), "secret-key")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, usage)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package zenrows
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	maxWait      = 30000
+	maxSessionID = 99999
+)
+
+// jsRenderParams are the parameters that only take effect when JavaScript rendering is enabled.
+var jsRenderParams = []string{
+	"js_instructions", "block_resources", "json_response", "window_width", "window_height",
+	"resolve_captcha", "wait_for", "wait", "antibot",
+}
+
+var boolParams = []string{
+	"js_render", "custom_headers", "premium_proxy", "json_response", "autoparse",
+	"resolve_captcha", "original_status", "antibot",
+}
+
+// OptionError describes a single invalid or conflicting scrape option.
+type OptionError struct {
+	// Param is the ZenRows parameter name the problem refers to.
+	Param string
+	// Value is the value of the parameter, empty when it's not set.
+	Value string
+	// Reason explains why the option is invalid.
+	Reason string
+}
+
+func (e *OptionError) Error() string {
+	if e.Value == "" {
+		return fmt.Sprintf("%s: %s", e.Param, e.Reason)
+	}
+	return fmt.Sprintf("%s=%q: %s", e.Param, e.Value, e.Reason)
+}
+
+// ValidateParameters checks the given ScrapeOptions for invalid or conflicting values.
+// See ValidateValues for details on the returned error.
+//
+// params: The ScrapeOptions to be validated.
+func ValidateParameters(params ...ScrapeOptions) error {
+	values := url.Values{}
+	for _, param := range params {
+		param(values)
+	}
+	return ValidateValues(values)
+}
+
+// ValidateValues checks ZenRows query parameters for invalid or conflicting values.
+//
+// It returns nil when the parameters are valid, otherwise an error joining one *OptionError per problem found,
+// which can be inspected with errors.As. The apikey and url parameters are not validated.
+//
+// values: The ZenRows query parameters.
+func ValidateValues(values url.Values) error {
+	var errs []error
+	invalid := func(param, reason string) {
+		errs = append(errs, &OptionError{Param: param, Value: values.Get(param), Reason: reason})
+	}
+	isTrue := func(param string) bool {
+		b, _ := strconv.ParseBool(values.Get(param))
+		return b
+	}
+	checkInt := func(param string, min, max int) {
+		if !values.Has(param) {
+			return
+		}
+		i, err := strconv.Atoi(values.Get(param))
+		switch {
+		case err != nil:
+			invalid(param, "must be an integer")
+		case i < min || i > max:
+			invalid(param, fmt.Sprintf("must be between %d and %d", min, max))
+		}
+	}
+
+	for _, param := range boolParams {
+		if values.Has(param) {
+			if _, err := strconv.ParseBool(values.Get(param)); err != nil {
+				invalid(param, "must be a boolean")
+			}
+		}
+	}
+
+	checkInt("wait", 0, maxWait)
+	checkInt("window_width", 1, math.MaxInt)
+	checkInt("window_height", 1, math.MaxInt)
+	checkInt("session_id", 1, maxSessionID)
+
+	if values.Has("device") && !Device(values.Get("device")).Valid() {
+		invalid("device", fmt.Sprintf("must be either %q or %q", DeviceDesktop, DeviceMobile))
+	}
+
+	if values.Has("response_type") && !ResponseType(values.Get("response_type")).Valid() {
+		invalid("response_type", fmt.Sprintf("must be one of %q, %q or %q", ResponseTypeMarkdown, ResponseTypePlaintext, ResponseTypePDF))
+	}
+
+	if values.Has("block_resources") {
+		for _, name := range strings.Split(values.Get("block_resources"), ",") {
+			name = strings.TrimSpace(name)
+			if name != "" && !ResourceType(name).Valid() {
+				invalid("block_resources", fmt.Sprintf("unknown resource type %q", name))
+			}
+		}
+	}
+
+	if values.Has("proxy_country") {
+		if !Country(values.Get("proxy_country")).Valid() {
+			invalid("proxy_country", "must be an ISO 3166-1 alpha-2 country code (ZenRows supports a subset of them)")
+		}
+		if !isTrue("premium_proxy") {
+			invalid("proxy_country", "requires premium_proxy to be enabled")
+		}
+	}
+
+	if values.Has("js_instructions") {
+		var instructions []json.RawMessage
+		if err := json.Unmarshal([]byte(values.Get("js_instructions")), &instructions); err != nil {
+			invalid("js_instructions", "must be a JSON array of instructions")
+		}
+	}
+
+	if values.Has("css_extractor") && isTrue("autoparse") {
+		invalid("css_extractor", "cannot be combined with autoparse")
+	}
+
+	if !isTrue("js_render") {
+		for _, param := range jsRenderParams {
+			if values.Has(param) {
+				invalid(param, "requires js_render to be enabled")
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate checks the request for invalid or conflicting values, see ValidateValues.
+// It also reports the Extra keys that name a typed field, which Values ignores.
+func (r ScrapeRequest) Validate() error {
+	var errs []error
+	names := make([]string, 0, len(r.Extra))
+	for name := range r.Extra {
+		if typedParams[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		errs = append(errs, &OptionError{Param: name, Value: r.Extra[name], Reason: "must be set with its ScrapeRequest field, not Extra"})
+	}
+	if err, ok := ValidateValues(r.Values()).(interface{ Unwrap() []error }); ok {
+		errs = append(errs, err.Unwrap()...)
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,178 @@
+package zenrows_test
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateParameters(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  []zenrows.ScrapeOptions
+		expected []string
+	}{
+		{
+			"No options",
+			nil,
+			nil,
+		},
+		{
+			"Valid options",
+			[]zenrows.ScrapeOptions{
+				zenrows.WithJSInstructions(`[{"click": ".selector"}]`),
+				zenrows.WithProxyCountry("US"),
+				zenrows.WithDevice("mobile"),
+				zenrows.WithWait(500),
+				zenrows.WithSessionID(1),
+				zenrows.WithCSSExtractor(`{"title": "h1"}`),
+			},
+			nil,
+		},
+		{
+			"Negative wait",
+			[]zenrows.ScrapeOptions{zenrows.WithWait(-1)},
+			[]string{"wait"},
+		},
+		{
+			"Zero window sizes",
+			[]zenrows.ScrapeOptions{zenrows.WithWindowWidth(0), zenrows.WithWindowHeight(0)},
+			[]string{"window_width", "window_height"},
+		},
+		{
+			"Unknown device",
+			[]zenrows.ScrapeOptions{zenrows.WithDevice("tablet")},
+			[]string{"device"},
+		},
+		{
+			"Unknown response type",
+			[]zenrows.ScrapeOptions{zenrows.WithResponseType("html")},
+			[]string{"response_type"},
+		},
+		{
+			"Invalid country code",
+			[]zenrows.ScrapeOptions{zenrows.WithProxyCountry("USA")},
+			[]string{"proxy_country"},
+		},
+		{
+			"Unassigned country code",
+			[]zenrows.ScrapeOptions{zenrows.WithProxyCountry("ZZ")},
+			[]string{"proxy_country"},
+		},
+		{
+			"Typed options",
+			[]zenrows.ScrapeOptions{
+				zenrows.WithProxyCountryCode(zenrows.CountryDE),
+				zenrows.WithDeviceType(zenrows.DeviceDesktop),
+				zenrows.WithResponseType(zenrows.ResponseTypeMarkdown),
+				zenrows.WithBlockResourceTypes(zenrows.ResourceImage, zenrows.ResourceMedia, zenrows.ResourceXHR),
+			},
+			nil,
+		},
+		{
+			"Unknown resource type",
+			[]zenrows.ScrapeOptions{zenrows.WithBlockResources("image,videos")},
+			[]string{"block_resources"},
+		},
+		{
+			"Proxy country without premium proxy",
+			[]zenrows.ScrapeOptions{zenrows.WithProxyCountry("US"), zenrows.WithoutParameters("premium_proxy")},
+			[]string{"proxy_country"},
+		},
+		{
+			"Proxy country set as a raw parameter",
+			[]zenrows.ScrapeOptions{zenrows.WithParameter("proxy_country", "US")},
+			[]string{"proxy_country"},
+		},
+		{
+			"Empty resource types are ignored",
+			[]zenrows.ScrapeOptions{zenrows.WithBlockResources("image, ,font,")},
+			nil,
+		},
+		{
+			"Empty block resources",
+			[]zenrows.ScrapeOptions{zenrows.WithBlockResources("")},
+			nil,
+		},
+		{
+			"CSS extractor with autoparse",
+			[]zenrows.ScrapeOptions{zenrows.WithCSSExtractor(".content"), zenrows.WithAutoparse(true)},
+			[]string{"css_extractor"},
+		},
+		{
+			"CSS extractor with autoparse disabled",
+			[]zenrows.ScrapeOptions{zenrows.WithCSSExtractor(".content"), zenrows.WithAutoparse(false)},
+			nil,
+		},
+		{
+			"Invalid JS instructions",
+			[]zenrows.ScrapeOptions{zenrows.WithJSInstructions(`{"click": ".selector"}`)},
+			[]string{"js_instructions"},
+		},
+		{
+			"JS instructions without js_render",
+			[]zenrows.ScrapeOptions{zenrows.WithJSInstructions(`[]`), zenrows.WithoutParameters("js_render")},
+			[]string{"js_instructions"},
+		},
+		{
+			"Session ID out of range",
+			[]zenrows.ScrapeOptions{zenrows.WithSessionID(100000)},
+			[]string{"session_id"},
+		},
+		{
+			"Invalid boolean",
+			[]zenrows.ScrapeOptions{zenrows.WithParameter("premium_proxy", "yes")},
+			[]string{"premium_proxy"},
+		},
+		{
+			"Multiple problems are all reported",
+			[]zenrows.ScrapeOptions{zenrows.WithWait(-1), zenrows.WithDevice("tablet"), zenrows.WithWindowWidth(-10)},
+			[]string{"wait", "window_width", "device"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := zenrows.ValidateParameters(tt.options...)
+			if tt.expected == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			joined, ok := err.(interface{ Unwrap() []error })
+			require.True(t, ok)
+
+			var params []string
+			for _, e := range joined.Unwrap() {
+				var optionErr *zenrows.OptionError
+				require.True(t, errors.As(e, &optionErr))
+				params = append(params, optionErr.Param)
+			}
+			assert.Equal(t, tt.expected, params)
+		})
+	}
+}
+
+func TestScrapeRequestValidate(t *testing.T) {
+	req, err := zenrows.ScrapeRequestFromValues(url.Values{"device": []string{"tablet"}})
+	require.NoError(t, err)
+
+	err = req.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `device="tablet"`)
+}
+
+func TestScrapeRequestValidateProxyCountry(t *testing.T) {
+	err := zenrows.ScrapeRequest{ProxyCountry: "US"}.Validate()
+	var optionErr *zenrows.OptionError
+	require.ErrorAs(t, err, &optionErr)
+	assert.Equal(t, "proxy_country", optionErr.Param)
+	assert.Equal(t, "requires premium_proxy to be enabled", optionErr.Reason)
+
+	assert.NoError(t, zenrows.ScrapeRequest{ProxyCountry: "US", PremiumProxy: zenrows.Bool(true)}.Validate())
+}
@@ -0,0 +1,362 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/renatoaraujo/go-zenrows"
+)
+
+// State is what steps share during a run.
+type State struct {
+	// URL is the current URL: the last one scraped or the one being followed.
+	URL string
+	// Response is the last response scraped.
+	Response *zenrows.Response
+	// Vars holds the initial variables of the run and the values extracted by the steps,
+	// either a string or a []string.
+	Vars map[string]any
+	// Records are the values recorded by the steps with Record set.
+	Records []map[string]any
+	// Errors are the errors of the steps with OnErrorContinue.
+	Errors []error
+}
+
+// Strings returns the values of a variable, nil when it isn't set.
+func (s *State) Strings(name string) []string {
+	switch v := s.Vars[name].(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			values = append(values, fmt.Sprint(item))
+		}
+		return values
+	case nil:
+		return nil
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
+
+// Value returns the first value of a variable, empty when it isn't set.
+func (s *State) Value(name string) string {
+	if values := s.Strings(name); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// Condition decides which steps of a branch are run; it matches when all of its set fields match.
+type Condition struct {
+	// Var is the variable Empty, Equals and Contains apply to.
+	Var string `yaml:"var,omitempty"`
+	// Empty matches when the variable has no values, or when it has some if false.
+	Empty *bool `yaml:"empty,omitempty"`
+	// Equals matches when the first value of the variable is equal to it.
+	Equals *string `yaml:"equals,omitempty"`
+	// Contains matches when a value of the variable contains it, or the last response body without Var.
+	Contains string `yaml:"contains,omitempty"`
+	// Status matches the status code of the last response.
+	Status int `yaml:"status,omitempty"`
+	// Func is a custom condition.
+	Func func(state *State) bool `yaml:"-"`
+}
+
+// Match reports whether the condition matches the state.
+func (c *Condition) Match(state *State) bool {
+	if c.Status != 0 && (state.Response == nil || state.Response.StatusCode != c.Status) {
+		return false
+	}
+
+	values := state.Strings(c.Var)
+	if c.Var == "" && state.Response != nil {
+		values = []string{state.Response.Body}
+	}
+	if c.Empty != nil && (len(values) == 0) != *c.Empty {
+		return false
+	}
+	if c.Equals != nil && state.Value(c.Var) != *c.Equals {
+		return false
+	}
+	if c.Contains != "" && !containsAny(values, c.Contains) {
+		return false
+	}
+
+	return c.Func == nil || c.Func(state)
+}
+
+func containsAny(values []string, substr string) bool {
+	for _, value := range values {
+		if strings.Contains(value, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+// StepError is the error of a failed step.
+type StepError struct {
+	Step string
+	URL  string
+	Err  error
+}
+
+func (e *StepError) Error() string {
+	if e.URL == "" {
+		return fmt.Sprintf("step %q failed: %v", e.Step, e.Err)
+	}
+	return fmt.Sprintf("step %q failed on %s: %v", e.Step, e.URL, e.Err)
+}
+
+func (e *StepError) Unwrap() error {
+	return e.Err
+}
+
+// RunOption configures a run of a Workflow.
+type RunOption func(*runConfig)
+
+type runConfig struct {
+	session *zenrows.Session
+	manager *zenrows.SessionManager
+}
+
+// WithSession runs the workflow through the given session, e.g. to reuse its cookies across runs.
+// The session is left open, closing it is up to the caller.
+func WithSession(session *zenrows.Session) RunOption {
+	return func(c *runConfig) {
+		c.session = session
+	}
+}
+
+// WithSessionManager runs the workflow through a new session of the given manager, e.g. to configure
+// how its session ID is rotated. The session is closed at the end of the run.
+func WithSessionManager(manager *zenrows.SessionManager) RunOption {
+	return func(c *runConfig) {
+		c.manager = manager
+	}
+}
+
+// Run runs the workflow with the given initial variables through a single session.
+// Unless configured with a RunOption, the session is created by a new SessionManager of the client with cookies;
+// the client is not used otherwise.
+//
+// The State is returned even when the run fails, with what was recorded until then.
+func (w *Workflow) Run(ctx context.Context, client *zenrows.Client, vars map[string]any, opts ...RunOption) (*State, error) {
+	if err := w.Validate(); err != nil {
+		return nil, err
+	}
+
+	var config runConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	session := config.session
+	if session == nil {
+		manager := config.manager
+		if manager == nil {
+			manager = zenrows.NewSessionManager(client).WithCookies()
+		}
+		var err error
+		if session, err = manager.NewSession(); err != nil {
+			return nil, fmt.Errorf("failed to create session: %w", err)
+		}
+		defer session.Close()
+	}
+
+	r := &run{workflow: w, session: session}
+	state := &State{Vars: cloneVars(vars)}
+	return state, r.steps(ctx, w.Steps, state)
+}
+
+type run struct {
+	workflow *Workflow
+	session  *zenrows.Session
+}
+
+func (r *run) steps(ctx context.Context, steps []Step, state *State) error {
+	for i := range steps {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		step := &steps[i]
+		err := r.step(ctx, step, state)
+		if err == nil {
+			continue
+		}
+
+		name := step.Name
+		if name == "" {
+			name = fmt.Sprintf("%s#%d", step.Type, i)
+		}
+		err = &StepError{Step: name, URL: state.URL, Err: err}
+		if step.OnError != OnErrorContinue || ctx.Err() != nil {
+			return err
+		}
+		state.Errors = append(state.Errors, err)
+	}
+	return nil
+}
+
+func (r *run) step(ctx context.Context, step *Step, state *State) error {
+	var err error
+	switch step.Type {
+	case StepScrape:
+		err = r.scrape(ctx, step, state)
+	case StepFollow:
+		err = r.follow(ctx, step, state)
+	case StepBranch:
+		if step.When.Match(state) {
+			err = r.steps(ctx, step.Then, state)
+		} else {
+			err = r.steps(ctx, step.Else, state)
+		}
+	}
+	if err != nil || step.Handler == nil {
+		return err
+	}
+	return step.Handler(ctx, state)
+}
+
+func (r *run) scrape(ctx context.Context, step *Step, state *State) error {
+	target, err := r.targetURL(step.URL, state)
+	if err != nil {
+		return err
+	}
+
+	params := append([]zenrows.ScrapeOptions{r.workflow.Options.Option(), step.Options.Option()}, step.ScrapeOptions...)
+	if len(step.Extract) > 0 {
+		selectors, err := json.Marshal(step.Extract)
+		if err != nil {
+			return fmt.Errorf("failed to encode css extractor: %w", err)
+		}
+		params = append(params, zenrows.WithCSSExtractor(string(selectors)))
+	}
+
+	resp, err := r.session.Execute(ctx, &zenrows.Call{TargetURL: target, Options: params})
+	if err != nil {
+		return err
+	}
+	state.URL = target
+	state.Response = resp
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var record map[string]any
+	if step.Record {
+		record = map[string]any{"url": target}
+	}
+	if len(step.Extract) > 0 {
+		var extracted map[string]any
+		if err := json.Unmarshal([]byte(resp.Body), &extracted); err != nil {
+			return fmt.Errorf("failed to decode extracted values: %w", err)
+		}
+		for name := range step.Extract {
+			value := normalize(extracted[name])
+			state.Vars[name] = value
+			if record != nil {
+				record[name] = value
+			}
+		}
+	}
+	if record != nil {
+		state.Records = append(state.Records, record)
+	}
+	return nil
+}
+
+func (r *run) follow(ctx context.Context, step *Step, state *State) error {
+	seen := map[string]struct{}{}
+	for _, link := range state.Strings(step.From) {
+		if step.Limit > 0 && len(seen) >= step.Limit {
+			break
+		}
+		target, err := resolve(state.URL, link)
+		if err != nil {
+			return err
+		}
+		if _, ok := seen[target]; ok {
+			continue
+		}
+		seen[target] = struct{}{}
+
+		child := &State{URL: target, Response: state.Response, Vars: cloneVars(state.Vars)}
+		err = r.steps(ctx, step.Steps, child)
+		state.Records = append(state.Records, child.Records...)
+		state.Errors = append(state.Errors, child.Errors...)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// targetURL executes the URL template of a step and resolves it against the current URL.
+func (r *run) targetURL(text string, state *State) (string, error) {
+	tmpl, err := parseURLTemplate(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid url template: %w", err)
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, state); err != nil {
+		return "", fmt.Errorf("failed to render url: %w", err)
+	}
+	if b.Len() == 0 {
+		if state.URL == "" {
+			return "", errors.New("no url to scrape")
+		}
+		return state.URL, nil
+	}
+	return resolve(state.URL, b.String())
+}
+
+func resolve(base, ref string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(ref))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse url %q: %w", ref, err)
+	}
+	if base != "" {
+		b, err := url.Parse(base)
+		if err != nil {
+			return "", fmt.Errorf("failed to parse url %q: %w", base, err)
+		}
+		u = b.ResolveReference(u)
+	}
+	return u.String(), nil
+}
+
+// normalize turns a value decoded from the CSS extractor into a string or a []string.
+func normalize(value any) any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return v
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			values = append(values, fmt.Sprint(item))
+		}
+		return values
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func cloneVars(vars map[string]any) map[string]any {
+	clone := make(map[string]any, len(vars))
+	for k, v := range vars {
+		clone[k] = v
+	}
+	return clone
+}
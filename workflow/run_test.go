@@ -0,0 +1,214 @@
+package workflow_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	"github.com/renatoaraujo/go-zenrows/workflow"
+	"github.com/renatoaraujo/go-zenrows/zenrowstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	srv := zenrowstest.NewServer("key")
+	defer srv.Close()
+	srv.Handle("https://shop.test/search?q=shoes", zenrowstest.Fixture{
+		Header: http.Header{"Zr-Cookies": {"visitor=42; Path=/"}},
+		Body:   `{"results":["/p/1","/p/2","/p/1"]}`,
+	})
+	srv.Handle("https://shop.test/p/1", zenrowstest.Fixture{Body: `{"title":"Red shoes"}`})
+	srv.Handle("https://shop.test/p/2", zenrowstest.Fixture{Body: `{"title":"Blue shoes"}`})
+
+	w, err := workflow.Parse([]byte(searchWorkflow))
+	require.NoError(t, err)
+
+	state, err := w.Run(context.Background(), srv.Client(), map[string]any{"query": "shoes"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []map[string]any{
+		{"url": "https://shop.test/p/1", "title": "Red shoes"},
+		{"url": "https://shop.test/p/2", "title": "Blue shoes"},
+	}, state.Records)
+	assert.Empty(t, state.Errors)
+	assert.Equal(t, []string{"/p/1", "/p/2", "/p/1"}, state.Strings("results"))
+
+	requests := srv.Requests()
+	require.Len(t, requests, 3)
+	session := requests[0].Options.SessionID
+	require.NotNil(t, session)
+	for _, req := range requests {
+		assert.Equal(t, session, req.Options.SessionID)
+		assert.Equal(t, zenrows.Bool(true), req.Options.PremiumProxy)
+	}
+	assert.Equal(t, `{"results":".result a @href"}`, requests[0].Options.CSSExtractor)
+	assert.Equal(t, zenrows.Bool(true), requests[0].Options.JSRender)
+	assert.Nil(t, requests[1].Options.JSRender)
+	assert.Equal(t, "visitor=42", requests[1].Header.Get("Cookie"))
+}
+
+func TestRunEscapesURLValues(t *testing.T) {
+	srv := zenrowstest.NewServer("key")
+	defer srv.Close()
+	srv.Handle("https://shop.test/search?q=red+%26+blue+%231", zenrowstest.Fixture{Body: `{"results":[]}`})
+	srv.Handle("https://shop.test/fallback", zenrowstest.Fixture{Body: "{}"})
+
+	w, err := workflow.Parse([]byte(searchWorkflow))
+	require.NoError(t, err)
+
+	_, err = w.Run(context.Background(), srv.Client(), map[string]any{"query": "red & blue #1"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://shop.test/search?q=red+%26+blue+%231", srv.Requests()[0].TargetURL)
+
+	step := workflow.Scrape("category", "https://shop.test/c/{{path .Vars.category}}")
+	srv.Handle("https://shop.test/c/men%2Fshoes", zenrowstest.Fixture{Body: "ok"})
+	_, err = (&workflow.Workflow{Steps: []workflow.Step{step}}).Run(context.Background(), srv.Client(), map[string]any{"category": "men/shoes"})
+	require.NoError(t, err)
+}
+
+func TestRunWithSession(t *testing.T) {
+	srv := zenrowstest.NewServer("key")
+	defer srv.Close()
+	srv.Handle("https://shop.test/login", zenrowstest.Fixture{Header: http.Header{"Zr-Cookies": {"token=secret; Path=/"}}})
+	srv.Handle("https://shop.test/account", zenrowstest.Fixture{Body: "ok"})
+
+	login := &workflow.Workflow{Steps: []workflow.Step{workflow.Scrape("login", "https://shop.test/login")}}
+	account := &workflow.Workflow{Steps: []workflow.Step{workflow.Scrape("account", "https://shop.test/account")}}
+
+	t.Run("session", func(t *testing.T) {
+		session, err := zenrows.NewSessionManager(srv.Client()).WithCookies().NewSession()
+		require.NoError(t, err)
+		defer session.Close()
+
+		_, err = login.Run(context.Background(), nil, nil, workflow.WithSession(session))
+		require.NoError(t, err)
+		_, err = account.Run(context.Background(), nil, nil, workflow.WithSession(session))
+		require.NoError(t, err)
+
+		requests := srv.Requests()
+		assert.Equal(t, "token=secret", requests[len(requests)-1].Header.Get("Cookie"))
+		_, err = session.ID()
+		assert.NoError(t, err)
+	})
+
+	t.Run("session manager", func(t *testing.T) {
+		manager := zenrows.NewSessionManager(srv.Client())
+		_, err := login.Run(context.Background(), nil, nil, workflow.WithSessionManager(manager))
+		require.NoError(t, err)
+		_, err = account.Run(context.Background(), nil, nil, workflow.WithSessionManager(manager))
+		require.NoError(t, err)
+
+		requests := srv.Requests()
+		assert.Empty(t, requests[len(requests)-1].Header.Get("Cookie"))
+	})
+}
+
+func TestRunBranch(t *testing.T) {
+	srv := zenrowstest.NewServer("key")
+	defer srv.Close()
+	srv.Handle("https://shop.test/search", zenrowstest.Fixture{Body: "<p>No results</p>"})
+	srv.HandleDefault(zenrowstest.Fixture{Body: "ok"})
+
+	var visited []string
+	visit := func(_ context.Context, state *workflow.State) error {
+		visited = append(visited, state.URL)
+		return nil
+	}
+	then := workflow.Scrape("suggestions", "/suggestions")
+	then.Handler = visit
+	otherwise := workflow.Scrape("results", "/results")
+	otherwise.Handler = visit
+
+	w := &workflow.Workflow{Steps: []workflow.Step{
+		workflow.Scrape("search", "https://shop.test/search", zenrows.WithDevice("mobile")),
+		workflow.Branch("empty", workflow.Condition{Contains: "No results", Status: http.StatusOK},
+			[]workflow.Step{then}, []workflow.Step{otherwise}),
+	}}
+
+	_, err := w.Run(context.Background(), srv.Client(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://shop.test/suggestions"}, visited)
+	assert.Equal(t, "mobile", srv.Requests()[0].Options.Device)
+}
+
+func TestRunErrors(t *testing.T) {
+	srv := zenrowstest.NewServer("key")
+	defer srv.Close()
+	srv.Handle("https://shop.test/list", zenrowstest.Fixture{Body: `{"links":["/ok","/missing"]}`})
+	srv.Handle("https://shop.test/ok", zenrowstest.Fixture{Body: "ok"})
+	srv.Handle("https://shop.test/missing", zenrowstest.Fixture{StatusCode: http.StatusNotFound})
+
+	detail := workflow.Scrape("detail", "")
+	detail.Record = true
+	list := workflow.Scrape("list", "https://shop.test/list")
+	list.Extract = map[string]string{"links": "a @href"}
+
+	t.Run("continue", func(t *testing.T) {
+		detail.OnError = workflow.OnErrorContinue
+		w := &workflow.Workflow{Steps: []workflow.Step{list, workflow.Follow("links", "links", detail)}}
+
+		state, err := w.Run(context.Background(), srv.Client(), nil)
+		require.NoError(t, err)
+		assert.Len(t, state.Records, 1)
+		require.Len(t, state.Errors, 1)
+		var stepErr *workflow.StepError
+		require.True(t, errors.As(state.Errors[0], &stepErr))
+		assert.Equal(t, "detail", stepErr.Step)
+		assert.Equal(t, "https://shop.test/missing", stepErr.URL)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		detail.OnError = ""
+		w := &workflow.Workflow{Steps: []workflow.Step{list, workflow.Follow("links", "links", detail)}}
+
+		state, err := w.Run(context.Background(), srv.Client(), nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `step "links" failed`)
+		assert.Contains(t, err.Error(), "unexpected status code 404")
+		assert.Len(t, state.Records, 1)
+	})
+
+	t.Run("invalid extraction", func(t *testing.T) {
+		invalid := workflow.Scrape("invalid", "https://shop.test/ok")
+		invalid.Extract = map[string]string{"title": "h1"}
+		w := &workflow.Workflow{Steps: []workflow.Step{invalid}}
+
+		_, err := w.Run(context.Background(), srv.Client(), nil)
+		assert.ErrorContains(t, err, "failed to decode extracted values")
+	})
+}
+
+func TestConditionMatch(t *testing.T) {
+	yes, no := true, false
+	next := "/page/2"
+	state := &workflow.State{
+		Response: &zenrows.Response{StatusCode: http.StatusOK, Body: "<h1>Results</h1>"},
+		Vars:     map[string]any{"next": next, "items": []string{"a", "b"}},
+	}
+
+	tests := []struct {
+		name      string
+		condition workflow.Condition
+		expected  bool
+	}{
+		{"empty condition", workflow.Condition{}, true},
+		{"var set", workflow.Condition{Var: "next", Empty: &no}, true},
+		{"var empty", workflow.Condition{Var: "missing", Empty: &yes}, true},
+		{"var not empty", workflow.Condition{Var: "items", Empty: &yes}, false},
+		{"equals", workflow.Condition{Var: "next", Equals: &next}, true},
+		{"contains in var", workflow.Condition{Var: "items", Contains: "b"}, true},
+		{"contains in body", workflow.Condition{Contains: "Results"}, true},
+		{"status", workflow.Condition{Status: http.StatusNotFound}, false},
+		{"func", workflow.Condition{Func: func(s *workflow.State) bool { return len(s.Strings("items")) == 2 }}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.condition.Match(state))
+		})
+	}
+}
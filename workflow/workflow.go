@@ -0,0 +1,217 @@
+// Package workflow runs multi-step scrapes, such as opening a search page, extracting the result links
+// and scraping each of them, on top of a zenrows.Client.
+//
+// Workflows are composed in Go or declared in YAML:
+//
+//	name: search
+//	options:
+//	  premium_proxy: true
+//	steps:
+//	  - name: search
+//	    type: scrape
+//	    url: https://example.com/search?q={{query .Vars.query}}
+//	    extract:
+//	      results: ".result a @href"
+//	  - name: results
+//	    type: follow
+//	    from: results
+//	    steps:
+//	      - name: product
+//	        type: scrape
+//	        extract:
+//	          title: h1
+//	          price: .price
+//	        record: true
+//
+// Every scrape of a run goes through the same zenrows.Session, sharing its session ID and cookies.
+// Use WithSession or WithSessionManager to provide it, e.g. to reuse cookies across runs.
+package workflow
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/renatoaraujo/go-zenrows"
+)
+
+// Step types.
+const (
+	// StepScrape scrapes a URL, optionally extracting fields from it with the ZenRows CSS extractor.
+	StepScrape = "scrape"
+	// StepFollow runs its steps for each URL held by a variable.
+	StepFollow = "follow"
+	// StepBranch runs its Then or Else steps depending on a Condition.
+	StepBranch = "branch"
+)
+
+// Error handling policies of a step.
+const (
+	// OnErrorFail stops the run when the step fails, it's the default.
+	OnErrorFail = "fail"
+	// OnErrorContinue records the error in the State and goes on with the next step.
+	OnErrorContinue = "continue"
+)
+
+// Workflow is a sequence of steps.
+type Workflow struct {
+	// Name identifies the workflow.
+	Name string `yaml:"name,omitempty"`
+	// Options are applied to every scrape of the workflow, before the options of the step.
+	Options zenrows.ScrapeRequest `yaml:"options,omitempty"`
+	// Steps are run in order.
+	Steps []Step `yaml:"steps"`
+}
+
+// Step is a single step of a Workflow.
+type Step struct {
+	// Name identifies the step in errors, it defaults to its type and position.
+	Name string `yaml:"name,omitempty"`
+	// Type is one of StepScrape, StepFollow or StepBranch.
+	Type string `yaml:"type"`
+
+	// URL is the URL to scrape, a text/template executed with the State. Relative URLs are resolved against
+	// the current URL, an empty URL scrapes the current URL again.
+	// Values are inserted as is: escape the ones put in a query string with query and in a path with path,
+	// e.g. "/search?q={{query .Vars.term}}", so that characters such as &, # or spaces don't corrupt the URL.
+	URL string `yaml:"url,omitempty"`
+	// Options are the scrape options of the step.
+	Options zenrows.ScrapeRequest `yaml:"options,omitempty"`
+	// Extract maps variable names to ZenRows CSS extractor selectors, e.g. "a.next @href".
+	// The extracted values are stored in the State variables.
+	Extract map[string]string `yaml:"extract,omitempty"`
+	// Record appends the URL and the extracted values of the step to the State records.
+	Record bool `yaml:"record,omitempty"`
+
+	// From is the variable holding the URLs a follow step runs its steps for.
+	From string `yaml:"from,omitempty"`
+	// Limit caps the number of URLs followed, zero means no limit.
+	Limit int `yaml:"limit,omitempty"`
+	// Steps are run for each followed URL, with their own copy of the variables.
+	Steps []Step `yaml:"steps,omitempty"`
+
+	// When is the condition of a branch step.
+	When *Condition `yaml:"when,omitempty"`
+	// Then are the steps run when the condition matches.
+	Then []Step `yaml:"then,omitempty"`
+	// Else are the steps run when the condition doesn't match.
+	Else []Step `yaml:"else,omitempty"`
+
+	// OnError is OnErrorFail or OnErrorContinue, empty means OnErrorFail.
+	OnError string `yaml:"on_error,omitempty"`
+
+	// ScrapeOptions are additional scrape options, applied after Options.
+	ScrapeOptions []zenrows.ScrapeOptions `yaml:"-"`
+	// Handler is called with the State once the step succeeded, an error fails the step.
+	Handler func(ctx context.Context, state *State) error `yaml:"-"`
+}
+
+// Scrape creates a scrape step.
+func Scrape(name, url string, params ...zenrows.ScrapeOptions) Step {
+	return Step{Name: name, Type: StepScrape, URL: url, ScrapeOptions: params}
+}
+
+// Follow creates a follow step running steps for each URL held by the from variable.
+func Follow(name, from string, steps ...Step) Step {
+	return Step{Name: name, Type: StepFollow, From: from, Steps: steps}
+}
+
+// Branch creates a branch step.
+func Branch(name string, when Condition, then, otherwise []Step) Step {
+	return Step{Name: name, Type: StepBranch, When: &when, Then: then, Else: otherwise}
+}
+
+// Parse decodes a YAML workflow and validates it.
+func Parse(data []byte) (*Workflow, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	var w Workflow
+	if err := dec.Decode(&w); err != nil {
+		return nil, fmt.Errorf("failed to decode workflow: %w", err)
+	}
+	if err := w.Validate(); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// Load reads and parses a YAML workflow file.
+func Load(path string) (*Workflow, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read workflow: %w", err)
+	}
+	return Parse(data)
+}
+
+// Validate checks the workflow can be run.
+func (w *Workflow) Validate() error {
+	if len(w.Steps) == 0 {
+		return errors.New("workflow has no steps")
+	}
+	return validateSteps("steps", w.Steps)
+}
+
+func validateSteps(path string, steps []Step) error {
+	var errs []error
+	for i, step := range steps {
+		errs = append(errs, step.validate(fmt.Sprintf("%s[%d]", path, i)))
+	}
+	return errors.Join(errs...)
+}
+
+func (s Step) validate(path string) error {
+	var errs []error
+	fail := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: "+format, append([]any{path}, args...)...))
+	}
+
+	switch s.Type {
+	case StepScrape:
+		if _, err := parseURLTemplate(s.URL); err != nil {
+			fail("invalid url template: %w", err)
+		}
+	case StepFollow:
+		if s.From == "" {
+			fail("follow step requires from")
+		}
+		if len(s.Steps) == 0 {
+			fail("follow step requires steps")
+		}
+		if s.Limit < 0 {
+			fail("limit must not be negative")
+		}
+		errs = append(errs, validateSteps(path+".steps", s.Steps))
+	case StepBranch:
+		if s.When == nil {
+			fail("branch step requires when")
+		}
+		errs = append(errs, validateSteps(path+".then", s.Then), validateSteps(path+".else", s.Else))
+	default:
+		fail("unknown step type %q", s.Type)
+	}
+
+	switch s.OnError {
+	case "", OnErrorFail, OnErrorContinue:
+	default:
+		fail("unknown on_error policy %q", s.OnError)
+	}
+	return errors.Join(errs...)
+}
+
+// urlFuncs are the functions available in URL templates.
+var urlFuncs = template.FuncMap{
+	"query": url.QueryEscape,
+	"path":  url.PathEscape,
+}
+
+func parseURLTemplate(text string) (*template.Template, error) {
+	return template.New("url").Option("missingkey=error").Funcs(urlFuncs).Parse(text)
+}
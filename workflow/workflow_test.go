@@ -0,0 +1,101 @@
+package workflow_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	"github.com/renatoaraujo/go-zenrows/workflow"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const searchWorkflow = `
+name: search
+options:
+  premium_proxy: true
+steps:
+  - name: search
+    type: scrape
+    url: https://shop.test/search?q={{query .Vars.query}}
+    options:
+      js_render: true
+    extract:
+      results: ".result a @href"
+  - name: results
+    type: follow
+    from: results
+    limit: 10
+    steps:
+      - name: product
+        type: scrape
+        extract:
+          title: h1
+        record: true
+        on_error: continue
+  - name: empty
+    type: branch
+    when:
+      var: results
+      empty: true
+    then:
+      - type: scrape
+        url: /fallback
+`
+
+func TestParse(t *testing.T) {
+	w, err := workflow.Parse([]byte(searchWorkflow))
+	require.NoError(t, err)
+
+	assert.Equal(t, "search", w.Name)
+	assert.Equal(t, zenrows.Bool(true), w.Options.PremiumProxy)
+	require.Len(t, w.Steps, 3)
+	assert.Equal(t, workflow.StepScrape, w.Steps[0].Type)
+	assert.Equal(t, map[string]string{"results": ".result a @href"}, w.Steps[0].Extract)
+	assert.Equal(t, "results", w.Steps[1].From)
+	assert.Equal(t, 10, w.Steps[1].Limit)
+	assert.Equal(t, workflow.OnErrorContinue, w.Steps[1].Steps[0].OnError)
+	require.NotNil(t, w.Steps[2].When)
+	assert.Equal(t, "results", w.Steps[2].When.Var)
+	require.Len(t, w.Steps[2].Then, 1)
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "workflow.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(searchWorkflow), 0o600))
+
+	w, err := workflow.Load(path)
+	require.NoError(t, err)
+	assert.Len(t, w.Steps, 3)
+
+	_, err = workflow.Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		yaml     string
+		expected string
+	}{
+		{"no steps", "name: empty\n", "workflow has no steps"},
+		{"unknown field", "steps:\n  - type: scrape\n    urll: x\n", "field urll not found"},
+		{"unknown type", "steps:\n  - type: click\n", `steps[0]: unknown step type "click"`},
+		{"follow without from", "steps:\n  - type: follow\n    steps:\n      - type: scrape\n", "steps[0]: follow step requires from"},
+		{"follow without steps", "steps:\n  - type: follow\n    from: links\n", "steps[0]: follow step requires steps"},
+		{"branch without when", "steps:\n  - type: branch\n", "steps[0]: branch step requires when"},
+		{"nested error", "steps:\n  - type: follow\n    from: links\n    steps:\n      - type: nope\n", `steps[0].steps[0]: unknown step type "nope"`},
+		{"invalid template", "steps:\n  - type: scrape\n    url: '{{.Vars'\n", "steps[0]: invalid url template"},
+		{"invalid on_error", "steps:\n  - type: scrape\n    url: https://a.test\n    on_error: retry\n", `unknown on_error policy "retry"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := workflow.Parse([]byte(tt.yaml))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expected)
+		})
+	}
+}
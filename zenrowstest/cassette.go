@@ -0,0 +1,179 @@
+package zenrowstest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/renatoaraujo/go-zenrows"
+)
+
+// ErrUnmatchedRequest is returned by a Recorder in replay mode when no recorded interaction matches a request.
+var ErrUnmatchedRequest = errors.New("no recorded interaction matches the request")
+
+// Mode defines whether a Recorder records or replays interactions.
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette without making any request.
+	ModeReplay Mode = iota
+	// ModeRecord makes real requests and stores them in the cassette.
+	ModeRecord
+)
+
+// Interaction is a recorded request and response pair.
+// Requests are identified by their target URL and options, the apikey is never stored.
+type Interaction struct {
+	TargetURL string                `json:"target_url"`
+	Options   zenrows.ScrapeRequest `json:"options"`
+	Response  RecordedResponse      `json:"response"`
+}
+
+// RecordedResponse is the response of a recorded interaction.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// Cassette is the on-disk format of the recorded interactions.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder is a zenrows.HttpClient recording interactions with the ZenRows API to a cassette file,
+// or replaying them from it, so integration tests only hit ZenRows once:
+//
+//	mode := zenrowstest.ModeReplay
+//	if os.Getenv("ZENROWS_RECORD") != "" {
+//	    mode = zenrowstest.ModeRecord
+//	}
+//	recorder, err := zenrowstest.NewRecorder("testdata/example.json", mode, http.DefaultClient)
+//	client := zenrows.NewClient(recorder).WithApiKey(os.Getenv("ZENROWS_API_KEY"))
+type Recorder struct {
+	path   string
+	mode   Mode
+	client zenrows.HttpClient
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+var _ zenrows.HttpClient = (*Recorder)(nil)
+
+// NewRecorder creates a Recorder for the cassette at path.
+//
+// In ModeReplay the cassette is loaded from path and client is not used, it can be nil.
+// In ModeRecord the cassette is overwritten and every request made through client is saved to it.
+func NewRecorder(path string, mode Mode, client zenrows.HttpClient) (*Recorder, error) {
+	r := &Recorder{path: path, mode: mode, client: client}
+	if mode == ModeRecord {
+		return r, r.save()
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+	if err := json.Unmarshal(data, &r.cassette); err != nil {
+		return nil, fmt.Errorf("failed to decode cassette %s: %w", path, err)
+	}
+	r.used = make([]bool, len(r.cassette.Interactions))
+	return r, nil
+}
+
+// Do records or replays the request depending on the Recorder mode.
+func (r *Recorder) Do(req *http.Request) (*http.Response, error) {
+	query := req.URL.Query()
+	targetURL := query.Get("url")
+	options, err := zenrows.ScrapeRequestFromValues(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request options: %w", err)
+	}
+
+	if r.mode == ModeRecord {
+		return r.record(req, targetURL, options)
+	}
+	return r.replay(req, targetURL, options)
+}
+
+func (r *Recorder) record(req *http.Request, targetURL string, options zenrows.ScrapeRequest) (*http.Response, error) {
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := RecordedResponse{StatusCode: resp.StatusCode, Header: resp.Header, Body: string(body)}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		TargetURL: targetURL,
+		Options:   options,
+		Response:  recorded,
+	})
+	err = r.save()
+	r.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return recorded.httpResponse(req), nil
+}
+
+func (r *Recorder) replay(req *http.Request, targetURL string, options zenrows.ScrapeRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Identical requests are served in recording order, repeating the last one once all have been used.
+	match := -1
+	for i, interaction := range r.cassette.Interactions {
+		if interaction.TargetURL != targetURL || !interaction.Options.Equal(options) {
+			continue
+		}
+		match = i
+		if !r.used[i] {
+			break
+		}
+	}
+	if match < 0 {
+		return nil, fmt.Errorf("%w in %s: url %s with options %q", ErrUnmatchedRequest, r.path, targetURL, options.String())
+	}
+
+	r.used[match] = true
+	return r.cassette.Interactions[match].Response.httpResponse(req), nil
+}
+
+// save writes the cassette, the caller must hold the lock or own the recorder.
+func (r *Recorder) save() error {
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+	if err := os.WriteFile(r.path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	return nil
+}
+
+func (r RecordedResponse) httpResponse(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Header:        r.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader([]byte(r.Body))),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,76 @@
+package zenrowstest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/renatoaraujo/go-zenrows"
+	"github.com/renatoaraujo/go-zenrows/zenrowstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	srv := zenrowstest.NewServer("secret-key")
+	srv.Handle("https://example.com/a", zenrowstest.Fixture{Body: "page a"})
+	srv.Handle("https://example.com/b", zenrowstest.Fixture{Body: "page b"})
+
+	recorder, err := zenrowstest.NewRecorder(path, zenrowstest.ModeRecord, http.DefaultClient)
+	require.NoError(t, err)
+
+	client := zenrows.NewClient(recorder).WithApiKey("secret-key").WithBaseURL(srv.URL)
+	content, err := client.Scrape(context.Background(), "https://example.com/a", zenrows.WithJSRender())
+	require.NoError(t, err)
+	assert.Equal(t, "page a", content)
+	content, err = client.Scrape(context.Background(), "https://example.com/b")
+	require.NoError(t, err)
+	assert.Equal(t, "page b", content)
+	srv.Close()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "secret-key")
+
+	replayer, err := zenrowstest.NewRecorder(path, zenrowstest.ModeReplay, nil)
+	require.NoError(t, err)
+	client = zenrows.NewClient(replayer).WithApiKey("other-key")
+
+	t.Run("Replays matching requests", func(t *testing.T) {
+		resp, err := client.Execute(context.Background(), &zenrows.Call{
+			TargetURL: "https://example.com/a",
+			Options:   []zenrows.ScrapeOptions{zenrows.WithJSRender()},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "page a", resp.Body)
+		assert.Equal(t, float64(5), resp.Cost())
+
+		content, err := client.Scrape(context.Background(), "https://example.com/b")
+		require.NoError(t, err)
+		assert.Equal(t, "page b", content)
+	})
+
+	t.Run("Fails on unmatched options", func(t *testing.T) {
+		_, err := client.Scrape(context.Background(), "https://example.com/a")
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, zenrowstest.ErrUnmatchedRequest))
+	})
+
+	t.Run("Fails on unmatched url", func(t *testing.T) {
+		_, err := client.Scrape(context.Background(), "https://example.com/c")
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, zenrowstest.ErrUnmatchedRequest))
+		assert.Contains(t, err.Error(), "https://example.com/c")
+	})
+}
+
+func TestRecorderMissingCassette(t *testing.T) {
+	_, err := zenrowstest.NewRecorder(filepath.Join(t.TempDir(), "missing.json"), zenrowstest.ModeReplay, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,325 @@
+// Package zenrowstest provides a fake ZenRows API for tests.
+//
+// The Server validates the parameters like the real API, serves configured fixtures per target URL,
+// simulates API errors and records every request it receives:
+//
+//	srv := zenrowstest.NewServer("test-key")
+//	defer srv.Close()
+//
+//	srv.Handle("https://example.com", zenrowstest.Fixture{Body: "<html></html>"})
+//	content, err := srv.Client().Scrape(ctx, "https://example.com")
+package zenrowstest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/renatoaraujo/go-zenrows"
+)
+
+// Limits reported by a Server unless configured otherwise.
+const (
+	DefaultConcurrencyLimit = 5
+	DefaultCreditLimit      = 1000
+)
+
+// Fixture is the response served for a target URL.
+type Fixture struct {
+	// StatusCode is the status of the response, http.StatusOK when zero.
+	StatusCode int
+	// Header contains additional response headers.
+	Header http.Header
+	// Body is the scraped content returned.
+	Body string
+	// Delay is waited before responding, e.g. to simulate timeouts. The wait stops if the client goes away.
+	Delay time.Duration
+}
+
+// Unauthorized returns a Fixture simulating a rejected apikey.
+func Unauthorized() Fixture {
+	return problemFixture(http.StatusUnauthorized, "API key is not valid")
+}
+
+// PaymentRequired returns a Fixture simulating an account without credits left.
+func PaymentRequired() Fixture {
+	return problemFixture(http.StatusPaymentRequired, "Usage exceeded")
+}
+
+// TooManyRequests returns a Fixture simulating a rate limited request, with the given Retry-After.
+func TooManyRequests(retryAfter time.Duration) Fixture {
+	f := problemFixture(http.StatusTooManyRequests, "Concurrency limit reached")
+	f.Header.Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())))
+	return f
+}
+
+// Timeout returns a Fixture responding only after the given delay, to exercise client timeouts.
+func Timeout(delay time.Duration) Fixture {
+	f := problemFixture(http.StatusGatewayTimeout, "Request timed out")
+	f.Delay = delay
+	return f
+}
+
+// Request is a request received by the Server.
+type Request struct {
+	// TargetURL is the value of the url parameter.
+	TargetURL string
+	// APIKey is the apikey the request was authenticated with.
+	APIKey string
+	// Options are the scrape options of the request.
+	Options zenrows.ScrapeRequest
+	// Header contains the request headers.
+	Header http.Header
+}
+
+// Server is a fake ZenRows API backed by httptest.Server.
+type Server struct {
+	// URL is the base URL of the fake API.
+	URL string
+
+	server *httptest.Server
+	apiKey string
+
+	mu               sync.Mutex
+	fixtures         map[string]Fixture
+	fallback         *Fixture
+	requests         []Request
+	concurrencyLimit int
+	inFlight         int
+	creditLimit      int
+	creditUsage      int
+}
+
+// NewServer starts a fake ZenRows API accepting the given apikey.
+// It has to be closed with Close.
+func NewServer(apiKey string) *Server {
+	s := &Server{
+		apiKey:           apiKey,
+		fixtures:         map[string]Fixture{},
+		concurrencyLimit: DefaultConcurrencyLimit,
+		creditLimit:      DefaultCreditLimit,
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL + "/v1/"
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns a zenrows.Client configured to use the server, with the accepted apikey.
+func (s *Server) Client() *zenrows.Client {
+	return zenrows.NewClient(s.server.Client()).
+		WithApiKey(s.apiKey).
+		WithBaseURL(s.URL)
+}
+
+// Handle configures the Fixture served when targetURL is scraped.
+func (s *Server) Handle(targetURL string, fixture Fixture) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixtures[targetURL] = fixture
+}
+
+// HandleDefault configures the Fixture served for target URLs without a fixture.
+// By default, such requests get a 404 response.
+func (s *Server) HandleDefault(fixture Fixture) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fallback = &fixture
+}
+
+// SetConcurrencyLimit configures the concurrency reported in the Concurrency-Limit header.
+// Requests over the limit get a 429 response.
+func (s *Server) SetConcurrencyLimit(limit int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.concurrencyLimit = limit
+}
+
+// SetCreditLimit configures the credits of the account reported by /usage.
+// Once they are spent, requests get a 402 response.
+func (s *Server) SetCreditLimit(limit int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.creditLimit = limit
+}
+
+// Requests returns the requests received so far, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Cost returns the credits ZenRows charges for a request with the given options.
+func Cost(options zenrows.ScrapeRequest) int {
+	jsRender := options.JSRender != nil && *options.JSRender
+	premiumProxy := options.PremiumProxy != nil && *options.PremiumProxy
+	switch {
+	case jsRender && premiumProxy:
+		return 25
+	case premiumProxy:
+		return 10
+	case jsRender:
+		return 5
+	default:
+		return 1
+	}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasSuffix(r.URL.Path, "/usage") {
+		s.serveUsage(w, r)
+		return
+	}
+
+	query := r.URL.Query()
+	apiKey := query.Get("apikey")
+	targetURL := query.Get("url")
+	query.Del("apikey")
+	query.Del("url")
+
+	options, err := zenrows.ScrapeRequestFromValues(query)
+	if err == nil {
+		err = zenrows.ValidateValues(query)
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		TargetURL: targetURL,
+		APIKey:    apiKey,
+		Options:   options,
+		Header:    r.Header.Clone(),
+	})
+	s.mu.Unlock()
+
+	switch {
+	case r.Method != http.MethodGet:
+		writeFixture(w, r, problemFixture(http.StatusMethodNotAllowed, "Method not allowed"))
+		return
+	case apiKey != s.apiKey:
+		writeFixture(w, r, Unauthorized())
+		return
+	case targetURL == "":
+		writeFixture(w, r, problemFixture(http.StatusBadRequest, "Missing url parameter"))
+		return
+	case err != nil:
+		writeFixture(w, r, problemFixture(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	s.mu.Lock()
+	exhausted := s.creditUsage >= s.creditLimit
+	s.mu.Unlock()
+	if exhausted {
+		writeFixture(w, r, PaymentRequired())
+		return
+	}
+
+	fixture, ok := s.acquire(targetURL)
+	if !ok {
+		writeFixture(w, r, TooManyRequests(time.Second))
+		return
+	}
+	defer s.release()
+
+	s.mu.Lock()
+	// Like ZenRows, only successful requests are billed.
+	if fixture.StatusCode == 0 || fixture.StatusCode/100 == 2 {
+		w.Header().Set("X-Request-Cost", strconv.Itoa(Cost(options)))
+		s.creditUsage += Cost(options)
+	}
+	w.Header().Set("Concurrency-Limit", strconv.Itoa(s.concurrencyLimit))
+	w.Header().Set("Concurrency-Remaining", strconv.Itoa(s.concurrencyLimit-s.inFlight))
+	s.mu.Unlock()
+	writeFixture(w, r, fixture)
+}
+
+func (s *Server) serveUsage(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("apikey") != s.apiKey {
+		writeFixture(w, r, Unauthorized())
+		return
+	}
+
+	s.mu.Lock()
+	usage := zenrows.Usage{
+		APICreditLimit:   float64(s.creditLimit),
+		APICreditUsage:   float64(s.creditUsage),
+		ConcurrencyLimit: s.concurrencyLimit,
+		ConcurrencyUsage: s.inFlight,
+	}
+	s.mu.Unlock()
+
+	body, _ := json.Marshal(usage)
+	writeFixture(w, r, Fixture{
+		Header: http.Header{"Content-Type": []string{"application/json"}},
+		Body:   string(body),
+	})
+}
+
+func (s *Server) acquire(targetURL string) (Fixture, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.inFlight >= s.concurrencyLimit {
+		return Fixture{}, false
+	}
+	s.inFlight++
+
+	if fixture, ok := s.fixtures[targetURL]; ok {
+		return fixture, true
+	}
+	if s.fallback != nil {
+		return *s.fallback, true
+	}
+	return problemFixture(http.StatusNotFound, fmt.Sprintf("No fixture for %s", targetURL)), true
+}
+
+func (s *Server) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inFlight--
+}
+
+func writeFixture(w http.ResponseWriter, r *http.Request, fixture Fixture) {
+	if fixture.Delay > 0 {
+		select {
+		case <-time.After(fixture.Delay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	for name, values := range fixture.Header {
+		w.Header()[name] = values
+	}
+	status := fixture.StatusCode
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(fixture.Body))
+}
+
+// problem is the RFC 7807 body ZenRows uses for errors.
+type problem struct {
+	Status int    `json:"status"`
+	Title  string `json:"title"`
+	Detail string `json:"detail,omitempty"`
+}
+
+func problemFixture(status int, detail string) Fixture {
+	body, _ := json.Marshal(problem{Status: status, Title: http.StatusText(status), Detail: detail})
+	return Fixture{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/problem+json"}},
+		Body:       string(body),
+	}
+}
@@ -0,0 +1,200 @@
+package zenrowstest_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/renatoaraujo/go-zenrows"
+	"github.com/renatoaraujo/go-zenrows/zenrowstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer(t *testing.T) {
+	tests := []struct {
+		name           string
+		fixture        *zenrowstest.Fixture
+		client         func(srv *zenrowstest.Server) *zenrows.Client
+		options        []zenrows.ScrapeOptions
+		timeout        time.Duration
+		expectedStatus int
+		expectedBody   string
+		expectedCost   float64
+		expectError    bool
+	}{
+		{
+			name:           "Serves the fixture",
+			fixture:        &zenrowstest.Fixture{Body: "<html>example</html>"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "<html>example</html>",
+			expectedCost:   1,
+		},
+		{
+			name:           "Reports the cost of the options",
+			fixture:        &zenrowstest.Fixture{Body: "<html>example</html>"},
+			options:        []zenrows.ScrapeOptions{zenrows.WithJSRender(), zenrows.WithPremiumProxy()},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "<html>example</html>",
+			expectedCost:   25,
+		},
+		{
+			name:           "Target without fixture",
+			expectedStatus: http.StatusNotFound,
+			expectedCost:   -1,
+		},
+		{
+			name: "Invalid apikey",
+			client: func(srv *zenrowstest.Server) *zenrows.Client {
+				return srv.Client().WithApiKey("wrong-key")
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedCost:   -1,
+		},
+		{
+			name:           "Payment required",
+			fixture:        ptr(zenrowstest.PaymentRequired()),
+			expectedStatus: http.StatusPaymentRequired,
+			expectedCost:   -1,
+		},
+		{
+			name:           "Too many requests",
+			fixture:        ptr(zenrowstest.TooManyRequests(5 * time.Second)),
+			expectedStatus: http.StatusTooManyRequests,
+			expectedCost:   -1,
+		},
+		{
+			name:        "Timeout",
+			fixture:     ptr(zenrowstest.Timeout(time.Minute)),
+			timeout:     50 * time.Millisecond,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := zenrowstest.NewServer("test-key")
+			defer srv.Close()
+
+			if tt.fixture != nil {
+				srv.Handle("https://example.com", *tt.fixture)
+			}
+
+			client := srv.Client()
+			if tt.client != nil {
+				client = tt.client(srv)
+			}
+
+			ctx := context.Background()
+			if tt.timeout != 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+
+			resp, err := client.Execute(ctx, &zenrows.Call{TargetURL: "https://example.com", Options: tt.options})
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, resp.Body)
+			}
+			assert.Equal(t, tt.expectedCost, resp.Cost())
+			if tt.expectedStatus == http.StatusTooManyRequests {
+				assert.Equal(t, "5", resp.Header.Get("Retry-After"))
+			}
+		})
+	}
+}
+
+func TestServerRecordsRequests(t *testing.T) {
+	srv := zenrowstest.NewServer("test-key")
+	defer srv.Close()
+	srv.HandleDefault(zenrowstest.Fixture{Body: "ok"})
+
+	client := srv.Client()
+	_, err := client.Scrape(context.Background(), "https://example.com/a", zenrows.WithDeviceType(zenrows.DeviceMobile))
+	require.NoError(t, err)
+	_, err = client.Scrape(context.Background(), "https://example.com/b")
+	require.NoError(t, err)
+
+	requests := srv.Requests()
+	require.Len(t, requests, 2)
+	assert.Equal(t, "https://example.com/a", requests[0].TargetURL)
+	assert.Equal(t, "test-key", requests[0].APIKey)
+	assert.Equal(t, "mobile", requests[0].Options.Device)
+	assert.Equal(t, "https://example.com/b", requests[1].TargetURL)
+}
+
+func TestServerConcurrencyLimit(t *testing.T) {
+	srv := zenrowstest.NewServer("test-key")
+	defer srv.Close()
+	srv.SetConcurrencyLimit(1)
+	srv.HandleDefault(zenrowstest.Fixture{Body: "ok", Delay: 200 * time.Millisecond})
+
+	client := srv.Client()
+	statuses := make([]int, 2)
+	var wg sync.WaitGroup
+	for i := range statuses {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, err := client.Execute(context.Background(), &zenrows.Call{TargetURL: "https://example.com"})
+			if assert.NoError(t, err) {
+				statuses[i] = resp.StatusCode
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.ElementsMatch(t, []int{http.StatusOK, http.StatusTooManyRequests}, statuses)
+}
+
+func TestServerCreditLimit(t *testing.T) {
+	srv := zenrowstest.NewServer("test-key")
+	defer srv.Close()
+	srv.SetCreditLimit(6)
+	srv.Handle("https://example.com/missing", zenrowstest.Fixture{StatusCode: http.StatusNotFound})
+	srv.HandleDefault(zenrowstest.Fixture{Body: "ok"})
+
+	client := srv.Client()
+	status := func(targetURL string, options ...zenrows.ScrapeOptions) int {
+		resp, err := client.Execute(context.Background(), &zenrows.Call{TargetURL: targetURL, Options: options})
+		require.NoError(t, err)
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, http.StatusNotFound, status("https://example.com/missing"))
+	assert.Equal(t, http.StatusOK, status("https://example.com", zenrows.WithJSRender()))
+	assert.Equal(t, http.StatusOK, status("https://example.com"))
+	assert.Equal(t, http.StatusPaymentRequired, status("https://example.com"))
+
+	usage, err := client.Usage(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, float64(6), usage.APICreditUsage)
+}
+
+func TestServerValidatesParameters(t *testing.T) {
+	srv := zenrowstest.NewServer("test-key")
+	defer srv.Close()
+	srv.HandleDefault(zenrowstest.Fixture{Body: "ok"})
+
+	resp, err := http.Get(srv.URL + "?apikey=test-key&url=https%3A%2F%2Fexample.com&wait=-1")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, "application/problem+json", resp.Header.Get("Content-Type"))
+	assert.Len(t, srv.Requests(), 1)
+}
+
+func ptr(f zenrowstest.Fixture) *zenrowstest.Fixture {
+	return &f
+}